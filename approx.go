@@ -0,0 +1,594 @@
+package menge
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"unsafe"
+)
+
+// toleranceKind is the kind of a Tolerance.
+type toleranceKind uint8
+
+const (
+	absTolerance toleranceKind = iota
+	relTolerance
+	ulpTolerance
+)
+
+// Tolerance decides whether two floating-point numbers are close enough to be
+// considered the same element of an ApproxSet. Equal numbers, including -0
+// and +0, are always within any tolerance, an infinity is only within
+// tolerance of itself, and NaN is not within tolerance of any number.
+// The zero value is an absolute tolerance of 0, i.e., exact comparison.
+type Tolerance struct {
+	kind toleranceKind
+	eps  float64
+	ulps uint64
+}
+
+// AbsTolerance returns a Tolerance within which a and b are if
+// |a - b| <= eps.
+func AbsTolerance(eps float64) Tolerance {
+	return Tolerance{kind: absTolerance, eps: eps}
+}
+
+// RelTolerance returns a Tolerance within which a and b are if
+// |a - b| <= eps * max(|a|, |b|). No number other than 0 is within a
+// relative tolerance of 0, so it does not suit values near 0.
+func RelTolerance(eps float64) Tolerance {
+	return Tolerance{kind: relTolerance, eps: eps}
+}
+
+// ULPTolerance returns a Tolerance within which a and b are if there are at
+// most n - 1 representable numbers of their type between them, i.e., if they
+// are at most n units in the last place apart. The numbers are compared in
+// their own type, so a tolerance of n ULPs is much wider for float32 than for
+// float64.
+func ULPTolerance(n uint64) Tolerance {
+	return Tolerance{kind: ulpTolerance, ulps: n}
+}
+
+// Within indicates whether a and b are within the tolerance of each other.
+// They are compared as float64 values, so ULP distances are those of float64.
+func (tol Tolerance) Within(a, b float64) bool {
+	return within(tol, a, b)
+}
+
+// String returns a string representation of the tolerance,
+// e.g., AbsTolerance(1e-09).
+func (tol Tolerance) String() string {
+	switch tol.kind {
+	case relTolerance:
+		return fmt.Sprintf("RelTolerance(%v)", tol.eps)
+	case ulpTolerance:
+		return fmt.Sprintf("ULPTolerance(%d)", tol.ulps)
+	}
+	return fmt.Sprintf("AbsTolerance(%v)", tol.eps)
+}
+
+// within indicates whether a and b are within tol of each other, computing
+// ULP distances in the type T.
+func within[T Float](tol Tolerance, a, b T) bool {
+	switch {
+	case a == b:
+		return true
+	case isNaN(a) || isNaN(b) || math.IsInf(float64(a), 0) || math.IsInf(float64(b), 0):
+		return false
+	}
+	switch tol.kind {
+	case relTolerance:
+		x, y := math.Abs(float64(a)), math.Abs(float64(b))
+		if y > x {
+			x = y
+		}
+		return math.Abs(float64(a)-float64(b)) <= tol.eps*x
+	case ulpTolerance:
+		return ulpDistance(a, b) <= tol.ulps
+	}
+	return math.Abs(float64(a)-float64(b)) <= tol.eps
+}
+
+// ulpDistance returns the number of units in the last place between a and b,
+// which are neither NaN nor infinite, in the type T.
+func ulpDistance[T Float](a, b T) uint64 {
+	var x, y int64
+	if unsafe.Sizeof(a) == 4 {
+		x, y = int64(ordered32(math.Float32bits(float32(a)))), int64(ordered32(math.Float32bits(float32(b))))
+	} else {
+		x, y = ordered64(math.Float64bits(float64(a))), ordered64(math.Float64bits(float64(b)))
+	}
+	if x < y {
+		x, y = y, x
+	}
+	return uint64(x) - uint64(y)
+}
+
+// ordered64 maps the bits of a float64 to an integer in the same order as
+// the float64, with both zeros mapped to 0.
+func ordered64(bits uint64) int64 {
+	if bits>>63 != 0 {
+		return -int64(bits &^ (1 << 63))
+	}
+	return int64(bits)
+}
+
+// ordered32 is like ordered64, but for a float32.
+func ordered32(bits uint32) int32 {
+	if bits>>31 != 0 {
+		return -int32(bits &^ (1 << 31))
+	}
+	return int32(bits)
+}
+
+// ApproxSet represents a set of floating-point numbers, in which numbers
+// within a Tolerance of each other are considered the same element, e.g., so
+// that 0.1+0.2 is found in a set that has 0.3.
+// Each element of the set is the representative of the numbers within
+// tolerance of it: the first of them to be added. Adding a number within
+// tolerance of an element does not add it, so no two elements of a set are
+// within tolerance of each other. However, a number may be within tolerance
+// of two elements, as tolerance is not transitive; Has reports whether there
+// is any, Nearest returns the nearest one, and Remove removes all of them.
+// The binary set operations compare the elements of t with those of s using
+// the tolerance of s, so s and t are expected to have the same tolerance.
+// The results consist of the elements of s and t; where an element of s and
+// one of t are within tolerance, that of s is kept. IntersectionSize and the
+// other sizes count the elements of s within tolerance of an element of t,
+// which can differ from the number of the elements of t within tolerance of
+// an element of s.
+// NaN values are ignored, as they are by Float64Set.
+// The zero value is an empty set ready to use, with exact comparison.
+type ApproxSet[T Float] struct {
+	tol   Tolerance
+	elems []T
+}
+
+// match returns the index range of the elements within tolerance of x, which
+// are consecutive, or, if there are none, an empty range at the index at
+// which x would be inserted.
+func (s *ApproxSet[T]) match(x T) (lo, hi int) {
+	i := sort.Search(len(s.elems), func(i int) bool { return s.elems[i] >= x })
+	lo, hi = i, i
+	for lo > 0 && within(s.tol, s.elems[lo-1], x) {
+		lo--
+	}
+	for hi < len(s.elems) && within(s.tol, s.elems[hi], x) {
+		hi++
+	}
+	return lo, hi
+}
+
+// Tolerance returns the tolerance of the set.
+func (s *ApproxSet[T]) Tolerance() Tolerance {
+	return s.tol
+}
+
+// Add adds zero or more elements to the set. An element within tolerance of
+// an element of the set is not added. NaN values are ignored.
+func (s *ApproxSet[T]) Add(elems ...T) {
+	for _, e := range elems {
+		if isNaN(e) {
+			continue
+		}
+		if lo, hi := s.match(e); lo == hi {
+			s.elems = insertAt(s.elems, lo, e)
+		}
+	}
+}
+
+// Remove removes the elements within tolerance of zero or more numbers from
+// the set.
+func (s *ApproxSet[T]) Remove(elems ...T) {
+	for _, e := range elems {
+		if lo, hi := s.match(e); lo != hi {
+			s.elems = append(s.elems[:lo], s.elems[hi:]...)
+		}
+	}
+}
+
+// Empty empties the set.
+func (s *ApproxSet[T]) Empty() {
+	s.elems = s.elems[:0]
+}
+
+// Has indicates whether the set has an element within tolerance of elem.
+func (s *ApproxSet[T]) Has(elem T) bool {
+	lo, hi := s.match(elem)
+	return lo != hi
+}
+
+// Nearest returns the element of the set within tolerance of x that is
+// nearest to x, and whether there is one. Of two elements equally near to x,
+// the lesser is returned.
+func (s *ApproxSet[T]) Nearest(x T) (T, bool) {
+	lo, hi := s.match(x)
+	if lo == hi {
+		var zero T
+		return zero, false
+	}
+	e := s.elems[lo]
+	for _, f := range s.elems[lo+1 : hi] {
+		if math.Abs(float64(f)-float64(x)) < math.Abs(float64(e)-float64(x)) {
+			e = f
+		}
+	}
+	return e, true
+}
+
+// Size returns the size of the set.
+func (s *ApproxSet[T]) Size() int {
+	return len(s.elems)
+}
+
+// IsEmpty indicates whether the set is empty.
+func (s *ApproxSet[T]) IsEmpty() bool {
+	return len(s.elems) == 0
+}
+
+// Clone returns a clone of the set.
+func (s *ApproxSet[T]) Clone() *ApproxSet[T] {
+	return &ApproxSet[T]{s.tol, append([]T(nil), s.elems...)}
+}
+
+// AsSlice returns an equivalent slice with the elements in ascending order.
+func (s *ApproxSet[T]) AsSlice() []T {
+	return s.AppendTo(make([]T, 0, len(s.elems)))
+}
+
+// AsSortedSlice returns an equivalent slice with the elements in ascending
+// order. It is the same as AsSlice.
+func (s *ApproxSet[T]) AsSortedSlice() []T {
+	return s.AsSlice()
+}
+
+// AsSortedSliceDesc returns an equivalent slice with the elements in
+// descending order.
+func (s *ApproxSet[T]) AsSortedSliceDesc() []T {
+	a := make([]T, len(s.elems))
+	for i, e := range s.elems {
+		a[len(a)-1-i] = e
+	}
+	return a
+}
+
+// AppendTo appends the elements of the set to dst in ascending order and
+// returns the extended slice. It allocates only if dst does not have enough
+// capacity.
+func (s *ApproxSet[T]) AppendTo(dst []T) []T {
+	return append(dst, s.elems...)
+}
+
+// ToSet returns a Set of the elements of the set, which can be converted to
+// the corresponding generated set type at no cost, e.g.,
+// Float64Set(s.ToSet()).
+func (s *ApproxSet[T]) ToSet() Set[T] {
+	return NewSet(s.elems...)
+}
+
+// String returns a string representation of the set,
+// with its elements in ascending order.
+func (s *ApproxSet[T]) String() string {
+	b := &strings.Builder{}
+	writeElems(b, s.elems, "%v", " ")
+	return b.String()
+}
+
+// Format implements the fmt.Formatter interface.
+// See Set.Format for the supported verbs. The %#v verb formats the set as
+// a call to NewApproxSet, with its Tolerance.
+func (s *ApproxSet[T]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		var zero T
+		fmt.Fprintf(f, "menge.NewApproxSet[%s](menge.%v", reflect.TypeOf(zero).String(), s.tol)
+		for _, e := range s.elems {
+			io.WriteString(f, ", "+goSyntax(e))
+		}
+		io.WriteString(f, ")")
+		return
+	}
+	formatElems(f, verb, s.elems, "")
+}
+
+// Equals indicates whether each element of s is within tolerance of an
+// element of t, and vice versa.
+func (s *ApproxSet[T]) Equals(t *ApproxSet[T]) bool {
+	return len(s.elems) == len(t.elems) && s.IsSubsetOf(t) && s.IsSupersetOf(t)
+}
+
+// Union returns the union of s and t.
+func (s *ApproxSet[T]) Union(t *ApproxSet[T]) *ApproxSet[T] {
+	r := s.Clone()
+	r.UnionWith(t)
+	return r
+}
+
+// Intersection returns the intersection of s and t, i.e., the elements of s
+// within tolerance of an element of t.
+func (s *ApproxSet[T]) Intersection(t *ApproxSet[T]) *ApproxSet[T] {
+	return s.Filter(func(e T) bool { return t.Has(e) })
+}
+
+// Difference returns the difference of s and t, i.e., s - t, the elements
+// of s not within tolerance of any element of t.
+func (s *ApproxSet[T]) Difference(t *ApproxSet[T]) *ApproxSet[T] {
+	return s.Filter(func(e T) bool { return !t.Has(e) })
+}
+
+// SymmetricDifference returns the symmetric difference of s and t,
+// i.e., (s - t) ⋃ (t - s).
+func (s *ApproxSet[T]) SymmetricDifference(t *ApproxSet[T]) *ApproxSet[T] {
+	r := s.Difference(t)
+	for _, e := range t.elems {
+		if !s.Has(e) {
+			r.elems = append(r.elems, e)
+		}
+	}
+	r.sort()
+	return r
+}
+
+// UnionWith adds the elements of t that are not within tolerance of any
+// element of s to s, i.e., s = s ⋃ t.
+func (s *ApproxSet[T]) UnionWith(t *ApproxSet[T]) {
+	// The new elements are collected apart, as Has searches the sorted
+	// elements of s.
+	var added []T
+	for _, e := range t.elems {
+		if !s.Has(e) {
+			added = append(added, e)
+		}
+	}
+	if len(added) == 0 {
+		return
+	}
+	a := make([]T, 0, len(s.elems)+len(added))
+	i, j := 0, 0
+	for i < len(s.elems) && j < len(added) {
+		if added[j] < s.elems[i] {
+			a = append(a, added[j])
+			j++
+		} else {
+			a = append(a, s.elems[i])
+			i++
+		}
+	}
+	a = append(append(a, s.elems[i:]...), added[j:]...)
+	s.elems = a
+}
+
+// sort restores the ascending order of the elements of s after others are
+// appended to them.
+func (s *ApproxSet[T]) sort() {
+	sort.Slice(s.elems, func(i, j int) bool { return s.elems[i] < s.elems[j] })
+}
+
+// IntersectWith removes the elements of s that are not within tolerance of
+// any element of t, i.e., s = s ⋂ t.
+func (s *ApproxSet[T]) IntersectWith(t *ApproxSet[T]) {
+	s.Retain(t.Has)
+}
+
+// DifferenceWith removes the elements of s that are within tolerance of an
+// element of t, i.e., s = s - t.
+func (s *ApproxSet[T]) DifferenceWith(t *ApproxSet[T]) {
+	if s == t {
+		s.Empty()
+		return
+	}
+	s.RemoveIf(t.Has)
+}
+
+// SymmetricDifferenceWith replaces s with the symmetric difference of s and t,
+// i.e., s = (s - t) ⋃ (t - s).
+func (s *ApproxSet[T]) SymmetricDifferenceWith(t *ApproxSet[T]) {
+	*s = *s.SymmetricDifference(t)
+}
+
+// IsSubsetOf indicates whether each element of s is within tolerance of an
+// element of t.
+func (s *ApproxSet[T]) IsSubsetOf(t *ApproxSet[T]) bool {
+	return s.Every(t.Has)
+}
+
+// IsProperSubsetOf indicates whether s is a subset of t and t is not a subset
+// of s.
+func (s *ApproxSet[T]) IsProperSubsetOf(t *ApproxSet[T]) bool {
+	return s.IsSubsetOf(t) && !t.IsSubsetOf(s)
+}
+
+// IsSupersetOf indicates whether s is a superset of t.
+func (s *ApproxSet[T]) IsSupersetOf(t *ApproxSet[T]) bool {
+	return t.IsSubsetOf(s)
+}
+
+// IsProperSupersetOf indicates whether s is a proper superset of t.
+func (s *ApproxSet[T]) IsProperSupersetOf(t *ApproxSet[T]) bool {
+	return t.IsProperSubsetOf(s)
+}
+
+// IsDisjointFrom indicates whether no element of s is within tolerance of an
+// element of t.
+func (s *ApproxSet[T]) IsDisjointFrom(t *ApproxSet[T]) bool {
+	return s.None(t.Has)
+}
+
+// IntersectionSize returns the number of the elements of s within tolerance
+// of an element of t, without computing the intersection.
+func (s *ApproxSet[T]) IntersectionSize(t *ApproxSet[T]) int {
+	return s.Count(t.Has)
+}
+
+// UnionSize returns the size of the union of s and t,
+// without computing the union.
+func (s *ApproxSet[T]) UnionSize(t *ApproxSet[T]) int {
+	return len(t.elems) + s.DifferenceSize(t)
+}
+
+// DifferenceSize returns the size of the difference of s and t, i.e., s - t,
+// without computing the difference.
+func (s *ApproxSet[T]) DifferenceSize(t *ApproxSet[T]) int {
+	return len(s.elems) - s.IntersectionSize(t)
+}
+
+// Jaccard returns the Jaccard index of s and t, i.e., |s ⋂ t| / |s ⋃ t|.
+// The Jaccard index of two empty sets is 1.
+func (s *ApproxSet[T]) Jaccard(t *ApproxSet[T]) float64 {
+	return jaccard(s.IntersectionSize(t), len(s.elems), len(t.elems))
+}
+
+// SorensenDice returns the Sørensen–Dice coefficient of s and t,
+// i.e., 2|s ⋂ t| / (|s| + |t|).
+// The Sørensen–Dice coefficient of two empty sets is 1.
+func (s *ApproxSet[T]) SorensenDice(t *ApproxSet[T]) float64 {
+	return sorensenDice(s.IntersectionSize(t), len(s.elems), len(t.elems))
+}
+
+// OverlapCoefficient returns the overlap coefficient of s and t,
+// i.e., |s ⋂ t| / min(|s|, |t|).
+// The overlap coefficient of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s *ApproxSet[T]) OverlapCoefficient(t *ApproxSet[T]) float64 {
+	return overlapCoefficient(s.IntersectionSize(t), len(s.elems), len(t.elems))
+}
+
+// CosineSimilarity returns the cosine similarity of s and t,
+// i.e., |s ⋂ t| / √(|s||t|).
+// The cosine similarity of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s *ApproxSet[T]) CosineSimilarity(t *ApproxSet[T]) float64 {
+	return cosineSimilarity(s.IntersectionSize(t), len(s.elems), len(t.elems))
+}
+
+// Filter returns a new set containing the elements of s for which pred
+// returns true.
+func (s *ApproxSet[T]) Filter(pred func(elem T) bool) *ApproxSet[T] {
+	r := &ApproxSet[T]{tol: s.tol}
+	for _, e := range s.elems {
+		if pred(e) {
+			r.elems = append(r.elems, e)
+		}
+	}
+	return r
+}
+
+// Retain removes the elements of s for which pred returns false.
+func (s *ApproxSet[T]) Retain(pred func(elem T) bool) {
+	a := s.elems[:0]
+	for _, e := range s.elems {
+		if pred(e) {
+			a = append(a, e)
+		}
+	}
+	s.elems = a
+}
+
+// RemoveIf removes the elements of s for which pred returns true.
+func (s *ApproxSet[T]) RemoveIf(pred func(elem T) bool) {
+	s.Retain(func(e T) bool { return !pred(e) })
+}
+
+// Partition returns two new sets containing the elements of s for which pred
+// returns true and false, respectively.
+func (s *ApproxSet[T]) Partition(pred func(elem T) bool) (in, out *ApproxSet[T]) {
+	in, out = &ApproxSet[T]{tol: s.tol}, &ApproxSet[T]{tol: s.tol}
+	for _, e := range s.elems {
+		if pred(e) {
+			in.elems = append(in.elems, e)
+		} else {
+			out.elems = append(out.elems, e)
+		}
+	}
+	return in, out
+}
+
+// Any indicates whether pred returns true for any element of s.
+// It returns false for an empty set.
+func (s *ApproxSet[T]) Any(pred func(elem T) bool) bool {
+	for _, e := range s.elems {
+		if pred(e) {
+			return true
+		}
+	}
+	return false
+}
+
+// Every indicates whether pred returns true for all elements of s.
+// It returns true for an empty set.
+func (s *ApproxSet[T]) Every(pred func(elem T) bool) bool {
+	for _, e := range s.elems {
+		if !pred(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// None indicates whether pred returns false for all elements of s.
+// It returns true for an empty set.
+func (s *ApproxSet[T]) None(pred func(elem T) bool) bool {
+	return !s.Any(pred)
+}
+
+// Count returns the number of elements of s for which pred returns true.
+func (s *ApproxSet[T]) Count(pred func(elem T) bool) int {
+	n := 0
+	for _, e := range s.elems {
+		if pred(e) {
+			n++
+		}
+	}
+	return n
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// See Set.MarshalJSON for the encoding.
+func (s *ApproxSet[T]) MarshalJSON() ([]byte, error) {
+	return marshalElems(s.elems)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It replaces the elements of the set with the decoded ones, added in their
+// order in the array, and keeps the tolerance of the set.
+func (s *ApproxSet[T]) UnmarshalJSON(data []byte) error {
+	var a []T
+	if err := json.Unmarshal(data, &a); err != nil || a == nil {
+		return err
+	}
+	s.elems = nil
+	s.Add(a...)
+	return nil
+}
+
+// NewApproxSet returns a new ApproxSet with a tolerance, containing zero or
+// more elements. Of the elements within tolerance of each other, the first
+// is added.
+func NewApproxSet[T Float](tol Tolerance, elems ...T) *ApproxSet[T] {
+	s := &ApproxSet[T]{tol: tol}
+	s.Add(elems...)
+	return s
+}
+
+// Approximate sets of all floating-point types.
+type (
+	// ApproxFloat32Set represents an approximate set of float32 elements.
+	ApproxFloat32Set = ApproxSet[float32]
+	// ApproxFloat64Set represents an approximate set of float64 elements.
+	ApproxFloat64Set = ApproxSet[float64]
+)
+
+// NewApproxFloat32Set returns a new ApproxFloat32Set with a tolerance,
+// containing zero or more elements.
+func NewApproxFloat32Set(tol Tolerance, elems ...float32) *ApproxFloat32Set {
+	return NewApproxSet(tol, elems...)
+}
+
+// NewApproxFloat64Set returns a new ApproxFloat64Set with a tolerance,
+// containing zero or more elements.
+func NewApproxFloat64Set(tol Tolerance, elems ...float64) *ApproxFloat64Set {
+	return NewApproxSet(tol, elems...)
+}
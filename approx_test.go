@@ -0,0 +1,168 @@
+package menge_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/soroushj/menge"
+)
+
+// tenth+fifth is not 0.3, unlike the constant expression 0.1+0.2.
+var tenth, fifth = 0.1, 0.2
+
+func TestTolerance(t *testing.T) {
+	inf, nan := math.Inf(1), math.NaN()
+	next := math.Nextafter(1, 2)
+	for _, tc := range []struct {
+		tol  menge.Tolerance
+		a, b float64
+		want bool
+	}{
+		{menge.Tolerance{}, tenth + fifth, 0.3, false},
+		{menge.Tolerance{}, math.Copysign(0, -1), 0, true},
+		{menge.AbsTolerance(1e-9), tenth + fifth, 0.3, true},
+		{menge.AbsTolerance(0.5), 1, 1.5, true},
+		{menge.AbsTolerance(0.5), 1, 1.6, false},
+		{menge.RelTolerance(0.01), 100, 101, true},
+		{menge.RelTolerance(0.01), 100, 102, false},
+		{menge.RelTolerance(0.01), 0, 1e-300, false},
+		{menge.ULPTolerance(1), 1, next, true},
+		{menge.ULPTolerance(1), 1, math.Nextafter(next, 2), false},
+		{menge.ULPTolerance(2), -math.SmallestNonzeroFloat64, math.SmallestNonzeroFloat64, true},
+		{menge.ULPTolerance(1), -math.SmallestNonzeroFloat64, math.SmallestNonzeroFloat64, false},
+		{menge.RelTolerance(1), inf, math.MaxFloat64, false},
+		{menge.ULPTolerance(1), inf, math.MaxFloat64, false},
+		{menge.AbsTolerance(1), inf, inf, true},
+		{menge.AbsTolerance(inf), nan, nan, false},
+	} {
+		if got := tc.tol.Within(tc.a, tc.b); got != tc.want || tc.tol.Within(tc.b, tc.a) != got {
+			t.Errorf("%v Within(%v, %v) got: %v", tc.tol, tc.a, tc.b, got)
+		}
+	}
+	f := menge.NewApproxFloat32Set(menge.ULPTolerance(1), 1)
+	if !f.Has(math.Nextafter32(1, 2)) || f.Has(math.Nextafter32(math.Nextafter32(1, 2), 2)) {
+		t.Errorf("float32 ULPs got: %v", f)
+	}
+}
+
+func TestApproxSet(t *testing.T) {
+	s := menge.NewApproxFloat64Set(menge.AbsTolerance(0.1), 1, 1.05, 2, 0.95, math.NaN())
+	if s.Size() != 2 || s.String() != "{1 2}" || !s.Has(1.09) || s.Has(1.11) || s.Has(math.NaN()) {
+		t.Errorf("got: %v", s)
+	}
+	s.Add(1.15)
+	if s.String() != "{1 1.15 2}" || !s.Has(1.08) {
+		t.Errorf("Add got: %v", s)
+	}
+	if e, ok := s.Nearest(1.08); !ok || e != 1.15 {
+		t.Errorf("Nearest got: %v %v", e, ok)
+	}
+	if e, ok := s.Nearest(1.5); ok {
+		t.Errorf("Nearest without a match got: %v", e)
+	}
+	s.Remove(1.08)
+	if s.String() != "{2}" {
+		t.Errorf("Remove of a number within tolerance of two elements got: %v", s)
+	}
+	var exact menge.ApproxFloat64Set
+	exact.Add(0.3, tenth+fifth)
+	if exact.Size() != 2 || exact.Tolerance() != (menge.Tolerance{}) {
+		t.Errorf("zero value got: %v", &exact)
+	}
+}
+
+func TestApproxSet_Operations(t *testing.T) {
+	tol := menge.AbsTolerance(0.01)
+	s := menge.NewApproxFloat64Set(tol, tenth+fifth, 1, 2)
+	u := menge.NewApproxFloat64Set(tol, 0.3, 2.001, 5)
+	for _, tc := range []struct {
+		name string
+		got  *menge.ApproxFloat64Set
+		want []float64
+	}{
+		{"Union", s.Union(u), []float64{tenth + fifth, 1, 2, 5}},
+		{"Union", u.Union(s), []float64{0.3, 1, 2.001, 5}},
+		{"Intersection", s.Intersection(u), []float64{tenth + fifth, 2}},
+		{"Difference", s.Difference(u), []float64{1}},
+		{"SymmetricDifference", s.SymmetricDifference(u), []float64{1, 5}},
+	} {
+		if got := tc.got.AsSlice(); !reflect.DeepEqual(got, tc.want) || tc.got.Tolerance() != tol {
+			t.Errorf("%s got: %v, want: %v", tc.name, got, tc.want)
+		}
+	}
+	if s.Equals(u) || !s.Intersection(u).Equals(u.Intersection(s)) || !s.Intersection(u).IsProperSubsetOf(s) || s.IsDisjointFrom(u) {
+		t.Errorf("relations got different results")
+	}
+	if s.IntersectionSize(u) != 2 || s.UnionSize(u) != 4 || s.DifferenceSize(u) != 1 || s.Jaccard(u) != 0.5 {
+		t.Errorf("sizes got different results")
+	}
+	c := s.Clone()
+	c.UnionWith(u)
+	c.DifferenceWith(menge.NewApproxFloat64Set(tol, 0.305))
+	c.SymmetricDifferenceWith(menge.NewApproxFloat64Set(tol, 1, 7))
+	if got := c.AsSortedSliceDesc(); !reflect.DeepEqual(got, []float64{7, 5, 2}) || s.Size() != 3 {
+		t.Errorf("mutations got: %v", got)
+	}
+	c.IntersectWith(u)
+	if got := c.AsSlice(); !reflect.DeepEqual(got, []float64{2, 5}) {
+		t.Errorf("IntersectWith got: %v", got)
+	}
+	in, out := s.Partition(func(e float64) bool { return e < 1.5 })
+	if in.Size() != 2 || out.Size() != 1 || !in.Has(0.3) || in.Tolerance() != tol {
+		t.Errorf("Partition got: %v %v", in, out)
+	}
+	if got := menge.Float64Set(s.ToSet()); got.Size() != 3 || !got.Has(tenth+fifth) {
+		t.Errorf("ToSet got: %v", got)
+	}
+}
+
+// TestApproxSet_UnionRandom checks that the unions of random sets are sorted
+// and have no two elements within tolerance of each other.
+func TestApproxSet_UnionRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tol := menge.AbsTolerance(0.5)
+	random := func() *menge.ApproxFloat64Set {
+		s := menge.NewApproxFloat64Set(tol)
+		for i := r.Intn(20); i > 0; i-- {
+			s.Add(r.Float64() * 20)
+		}
+		return s
+	}
+	for i := 0; i < 1000; i++ {
+		s, u := random(), random()
+		for _, got := range []*menge.ApproxFloat64Set{s.Union(u), u.Union(s)} {
+			a := got.AsSlice()
+			for j := 1; j < len(a); j++ {
+				if a[j-1] >= a[j] || tol.Within(a[j-1], a[j]) {
+					t.Fatalf("union of %v and %v got: %v", s, u, got)
+				}
+			}
+			if !got.IsSupersetOf(s) || !got.IsSupersetOf(u) {
+				t.Fatalf("union of %v and %v got: %v", s, u, got)
+			}
+		}
+	}
+	s := menge.NewApproxFloat64Set(tol, 8, 6.5, 12, 3)
+	s.UnionWith(menge.NewApproxFloat64Set(tol, 15.3, 8.8, 12.3, 6.3))
+	if got := s.AsSlice(); !reflect.DeepEqual(got, []float64{3, 6.5, 8, 8.8, 12, 15.3}) {
+		t.Errorf("UnionWith got: %v", got)
+	}
+}
+
+func TestApproxSet_Encoding(t *testing.T) {
+	s := menge.NewApproxFloat64Set(menge.RelTolerance(1e-9), 2, 0.5)
+	if got := fmt.Sprintf("%v %+v %.2f %#v", s, s, s, s); got != "{0.5 2} {0.5 2} (size 2) {0.50 2.00} menge.NewApproxSet[float64](menge.RelTolerance(1e-09), 0.5, 2)" {
+		t.Errorf("Format got: %s", got)
+	}
+	data, err := json.Marshal(s)
+	if err != nil || string(data) != `[0.5,2]` {
+		t.Errorf("MarshalJSON got: %s %v", data, err)
+	}
+	if err := json.Unmarshal([]byte(`[3,3.0000000001,1]`), s); err != nil || s.String() != "{1 3}" || s.Tolerance() != menge.RelTolerance(1e-9) {
+		t.Errorf("UnmarshalJSON got: %v %v", s, err)
+	}
+}
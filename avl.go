@@ -0,0 +1,294 @@
+package menge
+
+// node is a node of an AVL tree whose nodes also record the size of their
+// subtrees, so that elements can be ranked and selected in logarithmic time.
+// A nil *node is an empty tree.
+type node[T Ordered] struct {
+	elem        T
+	left, right *node[T]
+	height      int
+	size        int
+}
+
+func (n *node[T]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *node[T]) getSize() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// update recomputes the height and size of n from those of its children.
+func (n *node[T]) update() *node[T] {
+	l, r := n.left.getHeight(), n.right.getHeight()
+	if l > r {
+		n.height = l + 1
+	} else {
+		n.height = r + 1
+	}
+	n.size = n.left.getSize() + n.right.getSize() + 1
+	return n
+}
+
+func (n *node[T]) rotateLeft() *node[T] {
+	r := n.right
+	n.right = r.left
+	r.left = n.update()
+	return r.update()
+}
+
+func (n *node[T]) rotateRight() *node[T] {
+	l := n.left
+	n.left = l.right
+	l.right = n.update()
+	return l.update()
+}
+
+// balance restores the AVL property of n, whose children are balanced and
+// differ in height by at most two, and returns the new root of the subtree.
+func (n *node[T]) balance() *node[T] {
+	switch d := n.left.getHeight() - n.right.getHeight(); {
+	case d > 1:
+		if n.left.left.getHeight() < n.left.right.getHeight() {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case d < -1:
+		if n.right.right.getHeight() < n.right.left.getHeight() {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n.update()
+}
+
+// insert adds e to the tree rooted at n and returns the new root and whether
+// e was added.
+func (n *node[T]) insert(e T) (*node[T], bool) {
+	if n == nil {
+		return &node[T]{elem: e, height: 1, size: 1}, true
+	}
+	var added bool
+	switch {
+	case e < n.elem:
+		n.left, added = n.left.insert(e)
+	case n.elem < e:
+		n.right, added = n.right.insert(e)
+	default:
+		return n, false
+	}
+	if !added {
+		return n, false
+	}
+	return n.balance(), true
+}
+
+// delete removes e from the tree rooted at n and returns the new root and
+// whether e was removed.
+func (n *node[T]) delete(e T) (*node[T], bool) {
+	if n == nil {
+		return nil, false
+	}
+	var removed bool
+	switch {
+	case e < n.elem:
+		n.left, removed = n.left.delete(e)
+	case n.elem < e:
+		n.right, removed = n.right.delete(e)
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+		var min *node[T]
+		n.right, min = n.right.deleteMin()
+		min.left, min.right = n.left, n.right
+		return min.balance(), true
+	}
+	if !removed {
+		return n, false
+	}
+	return n.balance(), true
+}
+
+// deleteMin detaches the minimum node of the non-empty tree rooted at n and
+// returns the new root and the detached node.
+func (n *node[T]) deleteMin() (*node[T], *node[T]) {
+	if n.left == nil {
+		return n.right, n
+	}
+	var min *node[T]
+	n.left, min = n.left.deleteMin()
+	return n.balance(), min
+}
+
+// find returns the node of e in the tree rooted at n, or nil.
+func (n *node[T]) find(e T) *node[T] {
+	for n != nil {
+		switch {
+		case e < n.elem:
+			n = n.left
+		case n.elem < e:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+// clone returns a deep copy of the tree rooted at n.
+func (n *node[T]) clone() *node[T] {
+	if n == nil {
+		return nil
+	}
+	c := *n
+	c.left, c.right = n.left.clone(), n.right.clone()
+	return &c
+}
+
+// ascend calls f for the elements of the tree rooted at n in ascending order
+// until f returns false, and reports whether f never returned false.
+func (n *node[T]) ascend(f func(T) bool) bool {
+	return n == nil || n.left.ascend(f) && f(n.elem) && n.right.ascend(f)
+}
+
+// descend calls f for the elements of the tree rooted at n in descending
+// order until f returns false, and reports whether f never returned false.
+func (n *node[T]) descend(f func(T) bool) bool {
+	return n == nil || n.right.descend(f) && f(n.elem) && n.left.descend(f)
+}
+
+// ascendRange calls f for the elements e of the tree rooted at n such that
+// lo <= e < hi, in ascending order, until f returns false, and reports
+// whether f never returned false.
+func (n *node[T]) ascendRange(lo, hi T, f func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+	if lo < n.elem && !n.left.ascendRange(lo, hi, f) {
+		return false
+	}
+	if !(n.elem < lo) && n.elem < hi && !f(n.elem) {
+		return false
+	}
+	return !(n.elem < hi) || n.right.ascendRange(lo, hi, f)
+}
+
+// appendTo appends the elements of the tree rooted at n to dst in ascending
+// order and returns the extended slice.
+func (n *node[T]) appendTo(dst []T) []T {
+	if n == nil {
+		return dst
+	}
+	dst = n.left.appendTo(dst)
+	dst = append(dst, n.elem)
+	return n.right.appendTo(dst)
+}
+
+// build returns a balanced tree of the strictly ascending elements a.
+func build[T Ordered](a []T) *node[T] {
+	if len(a) == 0 {
+		return nil
+	}
+	m := len(a) / 2
+	n := &node[T]{elem: a[m]}
+	n.left, n.right = build(a[:m]), build(a[m+1:])
+	return n.update()
+}
+
+// rank returns the number of elements of the tree rooted at n that are less
+// than e.
+func (n *node[T]) rank(e T) int {
+	r := 0
+	for n != nil {
+		if n.elem < e {
+			r += n.left.getSize() + 1
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	return r
+}
+
+// at returns the node of the k-th smallest element of the tree rooted at n,
+// counting from zero, where 0 <= k < n.getSize().
+func (n *node[T]) at(k int) *node[T] {
+	for {
+		l := n.left.getSize()
+		switch {
+		case k < l:
+			n = n.left
+		case k > l:
+			k -= l + 1
+			n = n.right
+		default:
+			return n
+		}
+	}
+}
+
+// floor returns the node of the greatest element of the tree rooted at n that
+// is less than e, or also equal to it if inclusive is true, or nil.
+func (n *node[T]) floor(e T, inclusive bool) *node[T] {
+	var r *node[T]
+	for n != nil {
+		if n.elem < e || inclusive && !(e < n.elem) {
+			r = n
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	return r
+}
+
+// ceiling returns the node of the least element of the tree rooted at n that
+// is greater than e, or also equal to it if inclusive is true, or nil.
+func (n *node[T]) ceiling(e T, inclusive bool) *node[T] {
+	var r *node[T]
+	for n != nil {
+		if e < n.elem || inclusive && !(n.elem < e) {
+			r = n
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	return r
+}
+
+// mergeSorted walks the strictly ascending slices a and b in parallel and
+// calls f for each element of either, along with whether it is in a and b.
+func mergeSorted[T Ordered](a, b []T, f func(e T, inA, inB bool)) {
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			f(a[i], true, false)
+			i++
+		case b[j] < a[i]:
+			f(b[j], false, true)
+			j++
+		default:
+			f(a[i], true, true)
+			i++
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		f(a[i], true, false)
+	}
+	for ; j < len(b); j++ {
+		f(b[j], false, true)
+	}
+}
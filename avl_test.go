@@ -0,0 +1,60 @@
+package menge
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// check checks the AVL property and the recorded heights and sizes of the
+// tree rooted at n, and that its elements are in (lo, hi), if given.
+func (n *node[T]) check(t *testing.T, lo, hi *T) {
+	t.Helper()
+	if n == nil {
+		return
+	}
+	if lo != nil && !(*lo < n.elem) || hi != nil && !(n.elem < *hi) {
+		t.Fatalf("%v is out of order", n.elem)
+	}
+	n.left.check(t, lo, &n.elem)
+	n.right.check(t, &n.elem, hi)
+	l, r := n.left.getHeight(), n.right.getHeight()
+	if l-r > 1 || r-l > 1 {
+		t.Fatalf("%v is unbalanced: %d %d", n.elem, l, r)
+	}
+	h, size := n.height, n.size
+	if n.update(); n.height != h || n.size != size {
+		t.Fatalf("%v has height %d and size %d, want %d and %d", n.elem, h, size, n.height, n.size)
+	}
+}
+
+func TestOrderedSet_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	s := &OrderedSet[int]{}
+	m := make(Set[int])
+	for i := 0; i < 5000; i++ {
+		e := r.Intn(500)
+		if r.Intn(3) == 0 {
+			s.Remove(e)
+			m.Remove(e)
+		} else {
+			s.Add(e)
+			m.Add(e)
+		}
+		if i%100 == 0 {
+			s.root.check(t, nil, nil)
+		}
+	}
+	s.root.check(t, nil, nil)
+	if s.Size() != m.Size() {
+		t.Fatalf("got size %d, want %d", s.Size(), m.Size())
+	}
+	for i, e := range m.AsSortedSlice() {
+		if got, ok := s.Select(i); !ok || got != e || s.Rank(e) != i || !s.Has(e) {
+			t.Fatalf("Select(%d) got: %v, want %v", i, got, e)
+		}
+	}
+	for i := 0; i < 10; i++ {
+		u := s.Filter(func(e int) bool { return e%(i+2) == 0 })
+		u.root.check(t, nil, nil)
+	}
+}
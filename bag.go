@@ -0,0 +1,399 @@
+package menge
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Bag represents a multiset of elements of type T, i.e., a set in which an
+// element can occur more than once. It maps each of its elements to the
+// number of its occurrences, which is always positive.
+// NaN values are ignored, as they are by Float64Set, since they are not
+// equal to themselves.
+// Like Set, a Bag is a map; the zero value is nil and must be initialized
+// with make or NewBag before adding elements.
+type Bag[T comparable] map[T]int
+
+// BagEntry is an element of a bag and the number of its occurrences.
+type BagEntry[T comparable] struct {
+	Elem  T
+	Count int
+}
+
+// Add adds one occurrence of each of zero or more elements to the bag.
+// An element given more than once is added more than once.
+func (b Bag[T]) Add(elems ...T) {
+	for _, e := range elems {
+		b.AddN(e, 1)
+	}
+}
+
+// AddN adds n occurrences of an element to the bag.
+// It does nothing if n is not positive.
+func (b Bag[T]) AddN(elem T, n int) {
+	if n > 0 && !isNaN(elem) {
+		b[elem] += n
+	}
+}
+
+// Remove removes one occurrence of each of zero or more elements from the bag.
+// An element given more than once is removed more than once.
+func (b Bag[T]) Remove(elems ...T) {
+	for _, e := range elems {
+		b.RemoveN(e, 1)
+	}
+}
+
+// RemoveN removes n occurrences of an element from the bag, or all of its
+// occurrences if it has fewer than n. It does nothing if n is not positive.
+func (b Bag[T]) RemoveN(elem T, n int) {
+	if n <= 0 {
+		return
+	}
+	if c := b[elem]; c > n {
+		b[elem] = c - n
+	} else {
+		delete(b, elem)
+	}
+}
+
+// RemoveAll removes all occurrences of zero or more elements from the bag.
+func (b Bag[T]) RemoveAll(elems ...T) {
+	for _, e := range elems {
+		delete(b, e)
+	}
+}
+
+// Empty empties the bag.
+func (b Bag[T]) Empty() {
+	for e := range b {
+		delete(b, e)
+	}
+}
+
+// Count returns the number of occurrences of an element in the bag.
+func (b Bag[T]) Count(elem T) int {
+	return b[elem]
+}
+
+// Has indicates whether the bag has at least one occurrence of an element.
+func (b Bag[T]) Has(elem T) bool {
+	_, ok := b[elem]
+	return ok
+}
+
+// Size returns the number of distinct elements of the bag.
+// See Cardinality for the total number of occurrences.
+func (b Bag[T]) Size() int {
+	return len(b)
+}
+
+// Cardinality returns the total number of occurrences of the elements of
+// the bag.
+func (b Bag[T]) Cardinality() int {
+	n := 0
+	for _, c := range b {
+		n += c
+	}
+	return n
+}
+
+// IsEmpty indicates whether the bag is empty.
+func (b Bag[T]) IsEmpty() bool {
+	return len(b) == 0
+}
+
+// Clone returns a clone of the bag.
+func (b Bag[T]) Clone() Bag[T] {
+	c := make(Bag[T], len(b))
+	for e, n := range b {
+		c[e] = n
+	}
+	return c
+}
+
+// Support returns the set of the distinct elements of the bag. The result can
+// be converted to the corresponding generated set type at no cost, e.g.,
+// IntSet(b.Support()).
+func (b Bag[T]) Support() Set[T] {
+	s := make(Set[T], len(b))
+	for e := range b {
+		s[e] = struct{}{}
+	}
+	return s
+}
+
+// AsSlice returns an equivalent slice, in which each element occurs as many
+// times as it does in the bag, with no specific order of the elements.
+func (b Bag[T]) AsSlice() []T {
+	a := make([]T, 0, b.Cardinality())
+	for e, n := range b {
+		for i := 0; i < n; i++ {
+			a = append(a, e)
+		}
+	}
+	return a
+}
+
+// AsSortedSlice returns an equivalent slice, in which each element occurs as
+// many times as it does in the bag, with the elements in their natural order.
+// See LessFunc for the definition of the order.
+func (b Bag[T]) AsSortedSlice() []T {
+	a := b.AsSlice()
+	sortElems(a)
+	return a
+}
+
+// Entries returns the elements of the bag and their numbers of occurrences,
+// with the elements in their natural order.
+func (b Bag[T]) Entries() []BagEntry[T] {
+	keys := b.Support().AsSortedSlice()
+	a := make([]BagEntry[T], len(keys))
+	for i, e := range keys {
+		a[i] = BagEntry[T]{e, b[e]}
+	}
+	return a
+}
+
+// MostCommon returns the n elements of the bag with the most occurrences and
+// their numbers of occurrences, in descending order of the number of
+// occurrences. Elements with the same number of occurrences are in their
+// natural order. If n is negative or greater than the size of the bag,
+// it returns all of the elements.
+func (b Bag[T]) MostCommon(n int) []BagEntry[T] {
+	a := b.Entries()
+	sort.SliceStable(a, func(i, j int) bool {
+		return a[i].Count > a[j].Count
+	})
+	if n >= 0 && n < len(a) {
+		a = a[:n]
+	}
+	return a
+}
+
+// String returns a string representation of the bag, with its elements in
+// their natural order, each followed by the number of its occurrences,
+// e.g., {a:2 b:1}.
+func (b Bag[T]) String() string {
+	sb := &strings.Builder{}
+	sb.WriteByte('{')
+	for i, e := range b.Entries() {
+		if i != 0 {
+			sb.WriteByte(' ')
+		}
+		fmt.Fprintf(sb, "%v:%d", e.Elem, e.Count)
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+// Equals indicates whether b and t have the same elements with the same
+// numbers of occurrences.
+func (b Bag[T]) Equals(t Bag[T]) bool {
+	return len(b) == len(t) && b.IsSubsetOf(t)
+}
+
+// Union returns the union of b and t, in which the number of occurrences of
+// each element is the greater of those in b and t.
+func (b Bag[T]) Union(t Bag[T]) Bag[T] {
+	u := b.Clone()
+	for e, n := range t {
+		if n > u[e] {
+			u[e] = n
+		}
+	}
+	return u
+}
+
+// Sum returns the sum of b and t, in which the number of occurrences of each
+// element is the sum of those in b and t.
+func (b Bag[T]) Sum(t Bag[T]) Bag[T] {
+	u := b.Clone()
+	for e, n := range t {
+		u[e] += n
+	}
+	return u
+}
+
+// Intersection returns the intersection of b and t, in which the number of
+// occurrences of each element is the lesser of those in b and t.
+func (b Bag[T]) Intersection(t Bag[T]) Bag[T] {
+	if len(b) > len(t) {
+		b, t = t, b
+	}
+	u := make(Bag[T])
+	for e, n := range b {
+		if m := t[e]; m > 0 {
+			if m < n {
+				n = m
+			}
+			u[e] = n
+		}
+	}
+	return u
+}
+
+// Difference returns the difference of b and t, i.e., b - t, in which the
+// number of occurrences of each element is that in b minus that in t.
+// The elements that occur in t at least as many times as in b are removed.
+func (b Bag[T]) Difference(t Bag[T]) Bag[T] {
+	u := make(Bag[T])
+	for e, n := range b {
+		if n > t[e] {
+			u[e] = n - t[e]
+		}
+	}
+	return u
+}
+
+// IsSubsetOf indicates whether b is a subset of t, i.e., whether each element
+// occurs in t at least as many times as it does in b.
+func (b Bag[T]) IsSubsetOf(t Bag[T]) bool {
+	for e, n := range b {
+		if t[e] < n {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSupersetOf indicates whether b is a superset of t, i.e., whether each
+// element occurs in b at least as many times as it does in t.
+func (b Bag[T]) IsSupersetOf(t Bag[T]) bool {
+	return t.IsSubsetOf(b)
+}
+
+// NewBag returns a new Bag containing zero or more elements.
+// An element given more than once occurs more than once.
+func NewBag[T comparable](elems ...T) Bag[T] {
+	b := make(Bag[T], len(elems))
+	b.Add(elems...)
+	return b
+}
+
+// NewBagFromSet returns a new Bag containing one occurrence of each element
+// of s, which can be a Set or any of the generated set types, such as IntSet.
+func NewBagFromSet[S ~map[T]struct{}, T comparable](s S) Bag[T] {
+	b := make(Bag[T], len(s))
+	for e := range s {
+		b.Add(e)
+	}
+	return b
+}
+
+// Bags of all basic types.
+type (
+	// Complex128Bag represents a bag of complex128 elements.
+	Complex128Bag = Bag[complex128]
+	// Complex64Bag represents a bag of complex64 elements.
+	Complex64Bag = Bag[complex64]
+	// Float32Bag represents a bag of float32 elements.
+	Float32Bag = Bag[float32]
+	// Float64Bag represents a bag of float64 elements.
+	Float64Bag = Bag[float64]
+	// IntBag represents a bag of int elements.
+	IntBag = Bag[int]
+	// Int16Bag represents a bag of int16 elements.
+	Int16Bag = Bag[int16]
+	// Int32Bag represents a bag of int32 elements.
+	Int32Bag = Bag[int32]
+	// Int64Bag represents a bag of int64 elements.
+	Int64Bag = Bag[int64]
+	// Int8Bag represents a bag of int8 elements.
+	Int8Bag = Bag[int8]
+	// StringBag represents a bag of string elements.
+	StringBag = Bag[string]
+	// UIntBag represents a bag of uint elements.
+	UIntBag = Bag[uint]
+	// UInt16Bag represents a bag of uint16 elements.
+	UInt16Bag = Bag[uint16]
+	// UInt32Bag represents a bag of uint32 elements.
+	UInt32Bag = Bag[uint32]
+	// UInt64Bag represents a bag of uint64 elements.
+	UInt64Bag = Bag[uint64]
+	// UInt8Bag represents a bag of uint8 elements.
+	UInt8Bag = Bag[uint8]
+	// UIntPtrBag represents a bag of uintptr elements.
+	UIntPtrBag = Bag[uintptr]
+)
+
+// NewComplex128Bag returns a new Complex128Bag containing zero or more elements.
+func NewComplex128Bag(elems ...complex128) Complex128Bag {
+	return NewBag(elems...)
+}
+
+// NewComplex64Bag returns a new Complex64Bag containing zero or more elements.
+func NewComplex64Bag(elems ...complex64) Complex64Bag {
+	return NewBag(elems...)
+}
+
+// NewFloat32Bag returns a new Float32Bag containing zero or more elements.
+func NewFloat32Bag(elems ...float32) Float32Bag {
+	return NewBag(elems...)
+}
+
+// NewFloat64Bag returns a new Float64Bag containing zero or more elements.
+func NewFloat64Bag(elems ...float64) Float64Bag {
+	return NewBag(elems...)
+}
+
+// NewIntBag returns a new IntBag containing zero or more elements.
+func NewIntBag(elems ...int) IntBag {
+	return NewBag(elems...)
+}
+
+// NewInt16Bag returns a new Int16Bag containing zero or more elements.
+func NewInt16Bag(elems ...int16) Int16Bag {
+	return NewBag(elems...)
+}
+
+// NewInt32Bag returns a new Int32Bag containing zero or more elements.
+func NewInt32Bag(elems ...int32) Int32Bag {
+	return NewBag(elems...)
+}
+
+// NewInt64Bag returns a new Int64Bag containing zero or more elements.
+func NewInt64Bag(elems ...int64) Int64Bag {
+	return NewBag(elems...)
+}
+
+// NewInt8Bag returns a new Int8Bag containing zero or more elements.
+func NewInt8Bag(elems ...int8) Int8Bag {
+	return NewBag(elems...)
+}
+
+// NewStringBag returns a new StringBag containing zero or more elements.
+func NewStringBag(elems ...string) StringBag {
+	return NewBag(elems...)
+}
+
+// NewUIntBag returns a new UIntBag containing zero or more elements.
+func NewUIntBag(elems ...uint) UIntBag {
+	return NewBag(elems...)
+}
+
+// NewUInt16Bag returns a new UInt16Bag containing zero or more elements.
+func NewUInt16Bag(elems ...uint16) UInt16Bag {
+	return NewBag(elems...)
+}
+
+// NewUInt32Bag returns a new UInt32Bag containing zero or more elements.
+func NewUInt32Bag(elems ...uint32) UInt32Bag {
+	return NewBag(elems...)
+}
+
+// NewUInt64Bag returns a new UInt64Bag containing zero or more elements.
+func NewUInt64Bag(elems ...uint64) UInt64Bag {
+	return NewBag(elems...)
+}
+
+// NewUInt8Bag returns a new UInt8Bag containing zero or more elements.
+func NewUInt8Bag(elems ...uint8) UInt8Bag {
+	return NewBag(elems...)
+}
+
+// NewUIntPtrBag returns a new UIntPtrBag containing zero or more elements.
+func NewUIntPtrBag(elems ...uintptr) UIntPtrBag {
+	return NewBag(elems...)
+}
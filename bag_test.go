@@ -0,0 +1,97 @@
+package menge_test
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/soroushj/menge"
+)
+
+func TestBag(t *testing.T) {
+	b := menge.NewStringBag("the", "cat", "the", "hat", "the")
+	if b.Size() != 3 || b.Cardinality() != 5 || b.Count("the") != 3 || b.Count("dog") != 0 || !b.Has("cat") {
+		t.Errorf("got: %v", b)
+	}
+	b.AddN("cat", 2)
+	b.AddN("dog", 0)
+	b.Remove("hat", "the")
+	b.RemoveN("cat", 10)
+	b.RemoveN("the", -1)
+	if b.String() != "{the:2}" || b.Has("hat") || b.Has("dog") || b.Cardinality() != 2 {
+		t.Errorf("after removal got: %v", b)
+	}
+	b.RemoveAll("the")
+	if !b.IsEmpty() {
+		t.Errorf("RemoveAll got: %v", b)
+	}
+	f := menge.NewFloat64Bag(math.NaN(), 1, 1)
+	if f.Size() != 1 || f.Count(1) != 2 {
+		t.Errorf("NaN got: %v", f)
+	}
+	c := menge.NewIntBag(1, 1, 2)
+	d := c.Clone()
+	d.Add(3)
+	d.Empty()
+	if c.String() != "{1:2 2:1}" || !d.IsEmpty() {
+		t.Errorf("Clone got: %v %v", c, d)
+	}
+	if got := fmt.Sprintf("%#v", c); got != "menge.Bag[int]{1:2, 2:1}" {
+		t.Errorf("GoString got: %s", got)
+	}
+}
+
+func TestBag_Operations(t *testing.T) {
+	b := menge.NewIntBag(1, 1, 1, 2, 3, 3)
+	u := menge.NewIntBag(1, 2, 2, 4)
+	for _, tc := range []struct {
+		name string
+		got  menge.IntBag
+		want string
+	}{
+		{"Union", b.Union(u), "{1:3 2:2 3:2 4:1}"},
+		{"Sum", b.Sum(u), "{1:4 2:3 3:2 4:1}"},
+		{"Intersection", b.Intersection(u), "{1:1 2:1}"},
+		{"Difference", b.Difference(u), "{1:2 3:2}"},
+		{"Difference", u.Difference(b), "{2:1 4:1}"},
+	} {
+		if tc.got.String() != tc.want {
+			t.Errorf("%s got: %v, want: %s", tc.name, tc.got, tc.want)
+		}
+	}
+	if b.String() != "{1:3 2:1 3:2}" || u.String() != "{1:1 2:2 4:1}" {
+		t.Errorf("operands were modified: %v %v", b, u)
+	}
+	i := b.Intersection(u)
+	if !i.IsSubsetOf(b) || !i.IsSubsetOf(u) || !b.IsSupersetOf(i) || b.IsSubsetOf(u) || !i.Equals(menge.NewIntBag(2, 1)) || i.Equals(b) {
+		t.Errorf("relations got different results")
+	}
+}
+
+func TestBag_MostCommon(t *testing.T) {
+	b := menge.NewStringBag("b", "a", "c", "c", "b", "c", "d")
+	want := []menge.BagEntry[string]{{"c", 3}, {"b", 2}, {"a", 1}}
+	if got := b.MostCommon(3); !reflect.DeepEqual(got, want) {
+		t.Errorf("MostCommon got: %v", got)
+	}
+	if got := b.MostCommon(-1); len(got) != 4 || got[3] != (menge.BagEntry[string]{"d", 1}) {
+		t.Errorf("MostCommon(-1) got: %v", got)
+	}
+	if got := b.MostCommon(0); len(got) != 0 {
+		t.Errorf("MostCommon(0) got: %v", got)
+	}
+	if got := b.AsSortedSlice(); !reflect.DeepEqual(got, []string{"a", "b", "b", "c", "c", "c", "d"}) {
+		t.Errorf("AsSortedSlice got: %v", got)
+	}
+}
+
+func TestBag_Support(t *testing.T) {
+	b := menge.NewIntBag(3, 1, 3)
+	if s := menge.IntSet(b.Support()); !s.Equals(menge.NewIntSet(1, 3)) {
+		t.Errorf("Support got: %v", s)
+	}
+	if u := menge.NewBagFromSet(menge.NewIntSet(1, 3)); u.String() != "{1:1 3:1}" {
+		t.Errorf("NewBagFromSet got: %v", u)
+	}
+}
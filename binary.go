@@ -0,0 +1,358 @@
+package menge
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"math"
+	"reflect"
+)
+
+// binaryVersion is the version of the binary encoding of sets, which is the
+// first byte of the encoding.
+const binaryVersion = 1
+
+// The element encodings of the binary encoding of sets, one of which is the
+// second byte of the encoding.
+const (
+	binaryUnsigned = iota + 1
+	binarySigned
+	binaryFloat32
+	binaryFloat64
+	binaryComplex64
+	binaryComplex128
+	binaryString
+)
+
+// binaryEncoding returns the element encoding of the elements of type T and
+// the minimum size of the encoding of an element, or an error if elements of
+// type T cannot be binary-encoded.
+func binaryEncoding[T comparable]() (enc byte, size int, err error) {
+	var zero T
+	typ := reflect.TypeOf(&zero).Elem()
+	switch typ.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return binaryUnsigned, 1, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return binarySigned, 1, nil
+	case reflect.Float32:
+		return binaryFloat32, 4, nil
+	case reflect.Float64:
+		return binaryFloat64, 8, nil
+	case reflect.Complex64:
+		return binaryComplex64, 8, nil
+	case reflect.Complex128:
+		return binaryComplex128, 16, nil
+	case reflect.String:
+		return binaryString, 2, nil
+	}
+	return 0, 0, fmt.Errorf("menge: cannot binary-encode elements of type %v", typ)
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for sets
+// of integers, floats, complex numbers and strings; it returns an error for
+// other element types. The encoding consists of a version byte, a byte for
+// the kind of the elements, the number of the elements as a uvarint, the
+// elements in their natural order, and the CRC-32 (IEEE) checksum of all of
+// the above, little-endian. The elements are encoded as follows:
+//   - Unsigned integers: the first element as a uvarint, followed by the
+//     differences of each element from the previous one as uvarints.
+//   - Signed integers: the same, except that the first element is a zig-zag
+//     varint, as the differences of ascending elements are positive.
+//   - Floats and complex numbers: the little-endian IEEE 754 bits of each
+//     element, or of its real and imaginary parts, of the width of the type.
+//   - Strings: front-coded, i.e., the length of the prefix that each element
+//     shares with the previous one and the length of the rest of it as
+//     uvarints, followed by the rest of it.
+//
+// Integers of different sizes share an encoding, so a set can be decoded into
+// a set of a wider integer type of the same signedness.
+func (s Set[T]) MarshalBinary() ([]byte, error) {
+	enc, _, err := binaryEncoding[T]()
+	if err != nil {
+		return nil, err
+	}
+	b := []byte{binaryVersion, enc}
+	b = appendUvarint(b, uint64(len(s)))
+	a := s.AsSortedSlice()
+	switch enc {
+	case binaryUnsigned:
+		var prev uint64
+		for _, e := range a {
+			x := reflect.ValueOf(e).Uint()
+			b = appendUvarint(b, x-prev)
+			prev = x
+		}
+	case binarySigned:
+		var prev int64
+		for i, e := range a {
+			x := reflect.ValueOf(e).Int()
+			if i == 0 {
+				b = appendVarint(b, x)
+			} else {
+				b = appendUvarint(b, uint64(x)-uint64(prev))
+			}
+			prev = x
+		}
+	case binaryFloat32:
+		for _, e := range a {
+			b = appendUint32(b, math.Float32bits(float32(reflect.ValueOf(e).Float())))
+		}
+	case binaryFloat64:
+		for _, e := range a {
+			b = appendUint64(b, math.Float64bits(reflect.ValueOf(e).Float()))
+		}
+	case binaryComplex64:
+		for _, e := range a {
+			c := reflect.ValueOf(e).Complex()
+			b = appendUint32(b, math.Float32bits(float32(real(c))))
+			b = appendUint32(b, math.Float32bits(float32(imag(c))))
+		}
+	case binaryComplex128:
+		for _, e := range a {
+			c := reflect.ValueOf(e).Complex()
+			b = appendUint64(b, math.Float64bits(real(c)))
+			b = appendUint64(b, math.Float64bits(imag(c)))
+		}
+	case binaryString:
+		var prev string
+		for _, e := range a {
+			x := reflect.ValueOf(e).String()
+			n := 0
+			for n < len(prev) && n < len(x) && prev[n] == x[n] {
+				n++
+			}
+			b = appendUvarint(b, uint64(n))
+			b = appendUvarint(b, uint64(len(x)-n))
+			b = append(b, x[n:]...)
+			prev = x
+		}
+	}
+	return appendUint32(b, crc32.ChecksumIEEE(b)), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// It decodes the encoding produced by MarshalBinary and replaces the
+// elements of the set with the decoded ones. It returns an error and leaves
+// the set unchanged if the checksum does not match or the encoding is
+// otherwise invalid, e.g., if the elements are not in ascending natural
+// order.
+func (s *Set[T]) UnmarshalBinary(data []byte) error {
+	elems, err := unmarshalBinaryElems[T](data)
+	if err != nil {
+		return err
+	}
+	if *s == nil {
+		*s = make(Set[T], len(elems))
+	} else {
+		s.Empty()
+	}
+	s.Add(elems...)
+	return nil
+}
+
+// The errors of invalid binary encodings of sets.
+var (
+	errBinaryShort     = errors.New("menge: binary set is truncated")
+	errBinaryChecksum  = errors.New("menge: binary set checksum mismatch")
+	errBinaryAscending = errors.New("menge: binary set elements are not ascending")
+)
+
+// unmarshalBinaryElems decodes the binary encoding of a set, as produced by
+// Set.MarshalBinary, into its elements in their natural order.
+func unmarshalBinaryElems[T comparable](data []byte) ([]T, error) {
+	enc, size, err := binaryEncoding[T]()
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 4 {
+		return nil, errBinaryShort
+	}
+	body := data[:len(data)-4]
+	if crc32.ChecksumIEEE(body) != binary.LittleEndian.Uint32(data[len(body):]) {
+		return nil, errBinaryChecksum
+	}
+	d := decoder{data: body}
+	header, ok := d.bytes(2)
+	if !ok {
+		return nil, errBinaryShort
+	}
+	if header[0] != binaryVersion {
+		return nil, fmt.Errorf("menge: unsupported binary set version %d", header[0])
+	}
+	if header[1] != enc {
+		var zero T
+		return nil, fmt.Errorf("menge: binary set cannot be decoded into a set of %v", reflect.TypeOf(&zero).Elem())
+	}
+	n, ok := d.uvarint()
+	if !ok || n > uint64((len(body)-d.off)/size) {
+		return nil, errBinaryShort
+	}
+	elems := make([]T, n)
+	switch enc {
+	case binaryUnsigned:
+		var x uint64
+		for i := range elems {
+			delta, ok := d.uvarint()
+			if !ok {
+				return nil, errBinaryShort
+			}
+			if i > 0 && (delta == 0 || x+delta < x) {
+				return nil, errBinaryAscending
+			}
+			x += delta
+			v := reflect.ValueOf(&elems[i]).Elem()
+			if v.OverflowUint(x) {
+				return nil, fmt.Errorf("menge: element %d: %d overflows %v", i, x, v.Type())
+			}
+			v.SetUint(x)
+		}
+	case binarySigned:
+		var x int64
+		for i := range elems {
+			if i == 0 {
+				if x, ok = d.varint(); !ok {
+					return nil, errBinaryShort
+				}
+			} else {
+				delta, ok := d.uvarint()
+				if !ok {
+					return nil, errBinaryShort
+				}
+				if delta == 0 || delta > math.MaxInt64-uint64(x) {
+					return nil, errBinaryAscending
+				}
+				x = int64(uint64(x) + delta)
+			}
+			v := reflect.ValueOf(&elems[i]).Elem()
+			if v.OverflowInt(x) {
+				return nil, fmt.Errorf("menge: element %d: %d overflows %v", i, x, v.Type())
+			}
+			v.SetInt(x)
+		}
+	case binaryFloat32:
+		var prev float64
+		for i := range elems {
+			x, ok := d.uint32()
+			if !ok {
+				return nil, errBinaryShort
+			}
+			f := float64(math.Float32frombits(x))
+			if i > 0 && !floatAscending(prev, f) {
+				return nil, errBinaryAscending
+			}
+			reflect.ValueOf(&elems[i]).Elem().SetFloat(f)
+			prev = f
+		}
+	case binaryFloat64:
+		var prev float64
+		for i := range elems {
+			x, ok := d.uint64()
+			if !ok {
+				return nil, errBinaryShort
+			}
+			f := math.Float64frombits(x)
+			if i > 0 && !floatAscending(prev, f) {
+				return nil, errBinaryAscending
+			}
+			reflect.ValueOf(&elems[i]).Elem().SetFloat(f)
+			prev = f
+		}
+	case binaryComplex64:
+		var prev complex128
+		for i := range elems {
+			re, ok1 := d.uint32()
+			im, ok2 := d.uint32()
+			if !ok1 || !ok2 {
+				return nil, errBinaryShort
+			}
+			c := complex128(complex(math.Float32frombits(re), math.Float32frombits(im)))
+			if i > 0 && !complexAscending(prev, c) {
+				return nil, errBinaryAscending
+			}
+			reflect.ValueOf(&elems[i]).Elem().SetComplex(c)
+			prev = c
+		}
+	case binaryComplex128:
+		var prev complex128
+		for i := range elems {
+			re, ok1 := d.uint64()
+			im, ok2 := d.uint64()
+			if !ok1 || !ok2 {
+				return nil, errBinaryShort
+			}
+			c := complex(math.Float64frombits(re), math.Float64frombits(im))
+			if i > 0 && !complexAscending(prev, c) {
+				return nil, errBinaryAscending
+			}
+			reflect.ValueOf(&elems[i]).Elem().SetComplex(c)
+			prev = c
+		}
+	case binaryString:
+		var prev string
+		for i := range elems {
+			shared, ok1 := d.uvarint()
+			rest, ok2 := d.uvarint()
+			if !ok1 || !ok2 || rest > uint64(len(body)-d.off) {
+				return nil, errBinaryShort
+			}
+			if shared > uint64(len(prev)) {
+				return nil, fmt.Errorf("menge: element %d shares %d bytes with a previous element of %d bytes", i, shared, len(prev))
+			}
+			b, _ := d.bytes(int(rest))
+			x := prev[:shared] + string(b)
+			if i > 0 && x <= prev {
+				return nil, errBinaryAscending
+			}
+			reflect.ValueOf(&elems[i]).Elem().SetString(x)
+			prev = x
+		}
+	}
+	if d.off != len(body) {
+		return nil, errors.New("menge: trailing data after binary set")
+	}
+	return elems, nil
+}
+
+// floatAscending reports whether b may follow a in the encoding of a set,
+// i.e., whether b is ordered after a, or both are NaN, as a set may have
+// several NaN elements, which are never equal.
+func floatAscending(a, b float64) bool {
+	return floatLess(a, b) || !floatLess(b, a) && a != b
+}
+
+// complexAscending is floatAscending for complex numbers.
+func complexAscending(a, b complex128) bool {
+	return complexLess(a, b) || !complexLess(b, a) && a != b
+}
+
+// appendUvarint appends the varint encoding of x to b.
+func appendUvarint(b []byte, x uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(b, buf[:binary.PutUvarint(buf[:], x)]...)
+}
+
+// appendVarint appends the zig-zag varint encoding of x to b.
+func appendVarint(b []byte, x int64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(b, buf[:binary.PutVarint(buf[:], x)]...)
+}
+
+func (d *decoder) uvarint() (uint64, bool) {
+	x, n := binary.Uvarint(d.data[d.off:])
+	if n <= 0 {
+		return 0, false
+	}
+	d.off += n
+	return x, true
+}
+
+func (d *decoder) varint() (int64, bool) {
+	x, n := binary.Varint(d.data[d.off:])
+	if n <= 0 {
+		return 0, false
+	}
+	d.off += n
+	return x, true
+}
@@ -0,0 +1,216 @@
+package menge_test
+
+import (
+	"encoding"
+	"encoding/binary"
+	"encoding/hex"
+	"hash/crc32"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/soroushj/menge"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = menge.IntSet(nil)
+	_ encoding.BinaryUnmarshaler = (*menge.StringSet)(nil)
+	_ encoding.BinaryMarshaler   = menge.Set[uintptr](nil)
+)
+
+// seal appends the checksum to the hex-encoded body of a binary set.
+func seal(body string) []byte {
+	b, err := hex.DecodeString(body)
+	if err != nil {
+		panic(err)
+	}
+	sum := make([]byte, 4)
+	binary.LittleEndian.PutUint32(sum, crc32.ChecksumIEEE(b))
+	return append(b, sum...)
+}
+
+func TestSet_MarshalBinary(t *testing.T) {
+	cases := []struct {
+		set  interface{ MarshalBinary() ([]byte, error) }
+		want string
+	}{
+		{menge.NewIntSet(), "010200"},
+		{menge.NewIntSet(5, -2, 1), "0102030303" + "04"},
+		{menge.NewUInt64Set(1<<64-1, 300), "010102ac02" + "d3fdffffffffffffff01"},
+		{menge.NewInt64Set(math.MinInt64, math.MaxInt64), "010202ffffffffffffffffff01" + "ffffffffffffffffff01"},
+		{menge.NewFloat32Set(1, -2), "010302" + "000000c0" + "0000803f"},
+		{menge.NewFloat64Set(0.5), "010401000000000000e03f"},
+		{menge.NewComplex64Set(complex(1, -1)), "0105010000803f000080bf"},
+		{menge.NewComplex128Set(1i), "010601" + "0000000000000000" + "000000000000f03f"},
+		{menge.NewStringSet("banana", "apply", "apple"), "010703" + "00056170706c65" + "040179" + "000662616e616e61"},
+	}
+	for _, c := range cases {
+		got, err := c.set.MarshalBinary()
+		if want := seal(c.want); err != nil || string(got) != string(want) {
+			t.Errorf("%v got: %x, want: %x, error: %v", c.set, got, want, err)
+		}
+	}
+	if _, err := menge.NewSet(struct{}{}).MarshalBinary(); err == nil {
+		t.Errorf("struct elements got no error")
+	}
+}
+
+func TestSet_UnmarshalBinary(t *testing.T) {
+	i8, _ := menge.NewInt8Set(-128, 0, 127).MarshalBinary()
+	var i64 menge.Int64Set
+	if err := i64.UnmarshalBinary(i8); err != nil || !i64.Equals(menge.NewInt64Set(-128, 0, 127)) {
+		t.Errorf("wider type got: %v %v", i64, err)
+	}
+	nan, _ := menge.NewSet(math.NaN(), math.Copysign(0, -1), math.Inf(1)).MarshalBinary()
+	f := menge.NewFloat64Set(7)
+	if err := f.UnmarshalBinary(nan); err != nil || f.Size() != 2 || !f.Has(math.Inf(1)) || !math.Signbit(f.AsSortedSlice()[0]) {
+		t.Errorf("floats got: %v %v", f, err)
+	}
+	var s menge.Set[float64]
+	if err := s.UnmarshalBinary(nan); err != nil || s.Size() != 3 {
+		t.Errorf("NaN got: %v %v", s, err)
+	}
+	nans, _ := menge.NewSet(math.NaN(), math.NaN(), 1).MarshalBinary()
+	var n menge.Set[float64]
+	if err := n.UnmarshalBinary(nans); err != nil || n.Size() != 3 {
+		t.Errorf("several NaN got: %v %v", n, err)
+	}
+	cnans, _ := menge.NewSet(complex(1, math.NaN()), complex(1, math.NaN()), 1).MarshalBinary()
+	var c menge.Set[complex128]
+	if err := c.UnmarshalBinary(cnans); err != nil || c.Size() != 3 {
+		t.Errorf("several complex NaN got: %v %v", c, err)
+	}
+	str, _ := menge.NewStringSet("", "a", "ab", "b").MarshalBinary()
+	var u menge.StringSet
+	if err := u.UnmarshalBinary(str); err != nil || !u.Equals(menge.NewStringSet("", "a", "ab", "b")) {
+		t.Errorf("strings got: %v %v", u, err)
+	}
+}
+
+func TestSet_UnmarshalBinary_Errors(t *testing.T) {
+	valid, _ := menge.NewUInt8Set(1, 2).MarshalBinary()
+	flipped := append([]byte(nil), valid...)
+	flipped[3] ^= 1
+	cases := []struct {
+		set  encoding.BinaryUnmarshaler
+		data []byte
+	}{
+		{&menge.UInt8Set{}, nil},
+		{&menge.UInt8Set{}, valid[:len(valid)-1]},
+		{&menge.UInt8Set{}, flipped},
+		{&menge.UInt8Set{}, seal("")},
+		{&menge.UInt8Set{}, seal("020100")},
+		{&menge.UInt8Set{}, seal("010200")},
+		{&menge.UInt8Set{}, seal("01010201")},
+		{&menge.UInt8Set{}, seal("0101020100")},
+		{&menge.UInt8Set{}, seal("01010105" + "00")},
+		{&menge.UInt8Set{}, seal("010101ac02")},
+		{&menge.UInt64Set{}, seal("01010201ffffffffffffffffff01")},
+		{&menge.Int64Set{}, seal("010202feffffffffffffffff0101")},
+		{&menge.Float64Set{}, seal("010401000000000000e0")},
+		{&menge.Float64Set{}, seal("010402" + "000000000000f03f" + "000000000000e03f")},
+		{&menge.Float64Set{}, seal("010402" + "0000000000000000" + "0000000000000080")},
+		{&menge.Float32Set{}, seal("010302" + "0000803f" + "000000c0")},
+		{&menge.Float32Set{}, seal("01030100")},
+		{&menge.Complex64Set{}, seal("010502" + "0000803f00000000" + "0000803f00000000")},
+		{&menge.Complex128Set{}, seal("010602" + "0000000000000000000000000000f03f" + "00000000000000000000000000000000")},
+		{&menge.StringSet{}, seal("0107010101" + "61")},
+		{&menge.StringSet{}, seal("010702000162" + "000161")},
+		{&menge.StringSet{}, seal("0107010005" + "61")},
+		{&menge.Set[struct{}]{}, seal("010100")},
+	}
+	for _, c := range cases {
+		if err := c.set.UnmarshalBinary(c.data); err == nil {
+			t.Errorf("%x got no error", c.data)
+		}
+	}
+	s := menge.NewUInt8Set(7)
+	if s.UnmarshalBinary(flipped) == nil || !s.Equals(menge.NewUInt8Set(7)) {
+		t.Errorf("error got: %v", s)
+	}
+}
+
+func FuzzInt64Set_Binary(f *testing.F) {
+	f.Add([]byte{}, uint16(0))
+	f.Add([]byte("\x00\x00\x00\x00\x00\x00\x00\x80\xff\xff\xff\xff\xff\xff\xff\x7f\x01"), uint16(3))
+	f.Fuzz(func(t *testing.T, data []byte, flip uint16) {
+		s := menge.NewInt64Set()
+		for len(data) >= 8 {
+			s.Add(int64(binary.LittleEndian.Uint64(data)))
+			data = data[8:]
+		}
+		for _, b := range data {
+			s.Add(int64(int8(b)))
+		}
+		testBinaryRoundTrip(t, &s, &menge.Int64Set{}, flip)
+	})
+}
+
+func FuzzStringSet_Binary(f *testing.F) {
+	f.Add("", uint16(0))
+	f.Add("apple\x00apply\x00app\x00\x00banana", uint16(300))
+	f.Fuzz(func(t *testing.T, data string, flip uint16) {
+		s := menge.NewStringSet(strings.Split(data, "\x00")...)
+		testBinaryRoundTrip(t, &s, &menge.StringSet{}, flip)
+	})
+}
+
+func FuzzComplex128Set_Binary(f *testing.F) {
+	f.Add(1.0, -2.0, math.Inf(1), uint16(1))
+	f.Fuzz(func(t *testing.T, a, b, c float64, flip uint16) {
+		s := menge.NewComplex128Set(complex(a, b), complex(b, c), complex(c, a))
+		testBinaryRoundTrip(t, &s, &menge.Complex128Set{}, flip)
+	})
+}
+
+// binarySet is the interface of the sets with a binary encoding.
+type binarySet interface {
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+	String() string
+}
+
+// testBinaryRoundTrip checks that s is decoded into an empty set r as it is,
+// and that changing the byte of its encoding at flip is detected.
+func testBinaryRoundTrip(t *testing.T, s, r binarySet, flip uint16) {
+	data, err := s.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.UnmarshalBinary(data); err != nil || r.String() != s.String() {
+		t.Fatalf("%v got: %v, error: %v", s, r, err)
+	}
+	data[int(flip)%len(data)] ^= byte(flip>>8) | 1
+	if err := r.UnmarshalBinary(data); err == nil {
+		t.Errorf("%v with byte %d changed got no error", s, int(flip)%len(data))
+	}
+}
+
+func FuzzSet_UnmarshalBinary(f *testing.F) {
+	for _, b := range [][]byte{seal("010203030304"), seal("010703" + "00056170706c65" + "040179" + "000662616e616e61"), seal("010401000000000000e03f")} {
+		f.Add(b)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		testUnmarshalBinary[int64](t, data)
+		testUnmarshalBinary[uint8](t, data)
+		testUnmarshalBinary[float64](t, data)
+		testUnmarshalBinary[string](t, data)
+	})
+}
+
+// testUnmarshalBinary checks that arbitrary data, once decoded into a
+// Set[T], is encoded and decoded again into the same set.
+func testUnmarshalBinary[T comparable](t *testing.T, data []byte) {
+	var s menge.Set[T]
+	if s.UnmarshalBinary(data) != nil {
+		return
+	}
+	again, err := s.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var r menge.Set[T]
+	if err := r.UnmarshalBinary(again); err != nil || r.String() != s.String() {
+		t.Errorf("%x got: %v, then: %v, error: %v", data, s, r, err)
+	}
+}
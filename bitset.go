@@ -0,0 +1,477 @@
+package menge
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"reflect"
+	"strings"
+	"unsafe"
+)
+
+// Small is a constraint that permits any 8-bit or 16-bit integer type.
+type Small interface {
+	~int8 | ~uint8 | ~int16 | ~uint16
+}
+
+// BitSet represents a set of 8-bit or 16-bit integers as a bitmap with one
+// bit per possible element, i.e., 32 bytes for 8-bit and 8 KiB for 16-bit
+// integers. Add, Remove, and Has take constant time, and the binary set
+// operations combine the bitmaps a word at a time.
+// The zero value is an empty set ready to use.
+type BitSet[T Small] struct {
+	words []uint64
+}
+
+// bitLen returns the number of possible elements of type T.
+func bitLen[T Small]() int {
+	var zero T
+	return 1 << (8 * unsafe.Sizeof(zero))
+}
+
+// bitIndex returns the index of the bit of e. The elements are mapped to the
+// bits in ascending order.
+func bitIndex[T Small](e T) int {
+	if ^T(0) < 0 {
+		return int(e) + bitLen[T]()/2
+	}
+	return int(e)
+}
+
+// bitElem returns the element of the bit i. It is the inverse of bitIndex.
+func bitElem[T Small](i int) T {
+	if ^T(0) < 0 {
+		return T(i - bitLen[T]()/2)
+	}
+	return T(i)
+}
+
+// init allocates the bitmap of s if it is not allocated yet.
+func (s *BitSet[T]) init() {
+	if s.words == nil {
+		s.words = make([]uint64, bitLen[T]()/64)
+	}
+}
+
+// Add adds zero or more elements to the set.
+func (s *BitSet[T]) Add(elems ...T) {
+	if len(elems) == 0 {
+		return
+	}
+	s.init()
+	for _, e := range elems {
+		i := bitIndex(e)
+		s.words[i/64] |= 1 << (i % 64)
+	}
+}
+
+// Remove removes zero or more elements from the set.
+func (s *BitSet[T]) Remove(elems ...T) {
+	if s.words == nil {
+		return
+	}
+	for _, e := range elems {
+		i := bitIndex(e)
+		s.words[i/64] &^= 1 << (i % 64)
+	}
+}
+
+// Empty empties the set.
+func (s *BitSet[T]) Empty() {
+	for i := range s.words {
+		s.words[i] = 0
+	}
+}
+
+// Has indicates whether the set has an element.
+func (s *BitSet[T]) Has(elem T) bool {
+	if s.words == nil {
+		return false
+	}
+	i := bitIndex(elem)
+	return s.words[i/64]&(1<<(i%64)) != 0
+}
+
+// Size returns the size of the set.
+func (s *BitSet[T]) Size() int {
+	n := 0
+	for _, w := range s.words {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// IsEmpty indicates whether the set is empty.
+func (s *BitSet[T]) IsEmpty() bool {
+	for _, w := range s.words {
+		if w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a clone of the set.
+func (s *BitSet[T]) Clone() *BitSet[T] {
+	c := &BitSet[T]{}
+	if s.words != nil {
+		c.words = make([]uint64, len(s.words))
+		copy(c.words, s.words)
+	}
+	return c
+}
+
+// AsSlice returns an equivalent slice with the elements in ascending order.
+func (s *BitSet[T]) AsSlice() []T {
+	return s.AppendTo(make([]T, 0, s.Size()))
+}
+
+// AsSortedSlice returns an equivalent slice with the elements in ascending
+// order. It is the same as AsSlice.
+func (s *BitSet[T]) AsSortedSlice() []T {
+	return s.AsSlice()
+}
+
+// AsSortedSliceDesc returns an equivalent slice with the elements in
+// descending order.
+func (s *BitSet[T]) AsSortedSliceDesc() []T {
+	a := make([]T, 0, s.Size())
+	for i := len(s.words) - 1; i >= 0; i-- {
+		for w := s.words[i]; w != 0; {
+			j := 63 - bits.LeadingZeros64(w)
+			a = append(a, bitElem[T](i*64+j))
+			w &^= 1 << j
+		}
+	}
+	return a
+}
+
+// AppendTo appends the elements of the set to dst in ascending order and
+// returns the extended slice. It allocates only if dst does not have enough
+// capacity.
+func (s *BitSet[T]) AppendTo(dst []T) []T {
+	s.ascend(func(e T) bool {
+		dst = append(dst, e)
+		return true
+	})
+	return dst
+}
+
+// ascend calls f for each element of the set in ascending order,
+// until f returns false, and reports whether f never returned false.
+func (s *BitSet[T]) ascend(f func(T) bool) bool {
+	for i, w := range s.words {
+		for w != 0 {
+			j := bits.TrailingZeros64(w)
+			if !f(bitElem[T](i*64 + j)) {
+				return false
+			}
+			w &= w - 1
+		}
+	}
+	return true
+}
+
+// String returns a string representation of the set,
+// with its elements in ascending order.
+func (s *BitSet[T]) String() string {
+	b := &strings.Builder{}
+	b.Grow(s.Size() * 4)
+	writeElems(b, s.AsSlice(), "%v", " ")
+	return b.String()
+}
+
+// Format implements the fmt.Formatter interface.
+// See Set.Format for the supported verbs.
+func (s *BitSet[T]) Format(f fmt.State, verb rune) {
+	var zero T
+	formatElems(f, verb, s.AsSlice(), "menge.NewBitSet["+reflect.TypeOf(zero).String()+"]")
+}
+
+// word returns the i-th word of the bitmap of s, which is 0 if the bitmap is
+// not allocated.
+func (s *BitSet[T]) word(i int) uint64 {
+	if s.words == nil {
+		return 0
+	}
+	return s.words[i]
+}
+
+// Equals indicates whether s and t are equal.
+func (s *BitSet[T]) Equals(t *BitSet[T]) bool {
+	for i := 0; i < bitLen[T]()/64; i++ {
+		if s.word(i) != t.word(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// combine sets each word of s to the result of f for the corresponding words
+// of s and t.
+func (s *BitSet[T]) combine(t *BitSet[T], f func(a, b uint64) uint64) {
+	s.init()
+	for i := range s.words {
+		s.words[i] = f(s.words[i], t.word(i))
+	}
+}
+
+func bitOr(a, b uint64) uint64     { return a | b }
+func bitAnd(a, b uint64) uint64    { return a & b }
+func bitAndNot(a, b uint64) uint64 { return a &^ b }
+func bitXor(a, b uint64) uint64    { return a ^ b }
+
+// Union returns the union of s and t.
+func (s *BitSet[T]) Union(t *BitSet[T]) *BitSet[T] {
+	r := s.Clone()
+	r.combine(t, bitOr)
+	return r
+}
+
+// Intersection returns the intersection of s and t.
+func (s *BitSet[T]) Intersection(t *BitSet[T]) *BitSet[T] {
+	r := s.Clone()
+	r.combine(t, bitAnd)
+	return r
+}
+
+// Difference returns the difference of s and t, i.e., s - t.
+func (s *BitSet[T]) Difference(t *BitSet[T]) *BitSet[T] {
+	r := s.Clone()
+	r.combine(t, bitAndNot)
+	return r
+}
+
+// SymmetricDifference returns the symmetric difference of s and t,
+// i.e., the elements that are in either s or t but not in both.
+func (s *BitSet[T]) SymmetricDifference(t *BitSet[T]) *BitSet[T] {
+	r := s.Clone()
+	r.combine(t, bitXor)
+	return r
+}
+
+// UnionWith adds the elements of t to s, i.e., s = s ⋃ t.
+func (s *BitSet[T]) UnionWith(t *BitSet[T]) {
+	s.combine(t, bitOr)
+}
+
+// IntersectWith removes the elements of s that are not in t, i.e., s = s ⋂ t.
+func (s *BitSet[T]) IntersectWith(t *BitSet[T]) {
+	s.combine(t, bitAnd)
+}
+
+// DifferenceWith removes the elements of t from s, i.e., s = s - t.
+func (s *BitSet[T]) DifferenceWith(t *BitSet[T]) {
+	s.combine(t, bitAndNot)
+}
+
+// SymmetricDifferenceWith replaces s with the symmetric difference of s and t,
+// i.e., removes the elements of t that are in s and adds those that are not.
+func (s *BitSet[T]) SymmetricDifferenceWith(t *BitSet[T]) {
+	s.combine(t, bitXor)
+}
+
+// IsSubsetOf indicates whether s is a subset of t.
+func (s *BitSet[T]) IsSubsetOf(t *BitSet[T]) bool {
+	for i := range s.words {
+		if s.words[i]&^t.word(i) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsProperSubsetOf indicates whether s is a proper subset of t.
+func (s *BitSet[T]) IsProperSubsetOf(t *BitSet[T]) bool {
+	return s.IsSubsetOf(t) && !s.Equals(t)
+}
+
+// IsSupersetOf indicates whether s is a superset of t.
+func (s *BitSet[T]) IsSupersetOf(t *BitSet[T]) bool {
+	return t.IsSubsetOf(s)
+}
+
+// IsProperSupersetOf indicates whether s is a proper superset of t.
+func (s *BitSet[T]) IsProperSupersetOf(t *BitSet[T]) bool {
+	return t.IsProperSubsetOf(s)
+}
+
+// IsDisjointFrom indicates whether s and t are disjoint.
+func (s *BitSet[T]) IsDisjointFrom(t *BitSet[T]) bool {
+	for i := range s.words {
+		if s.words[i]&t.word(i) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IntersectionSize returns the size of the intersection of s and t,
+// without computing the intersection.
+func (s *BitSet[T]) IntersectionSize(t *BitSet[T]) int {
+	n := 0
+	for i := range s.words {
+		n += bits.OnesCount64(s.words[i] & t.word(i))
+	}
+	return n
+}
+
+// UnionSize returns the size of the union of s and t,
+// without computing the union.
+func (s *BitSet[T]) UnionSize(t *BitSet[T]) int {
+	return s.Size() + t.Size() - s.IntersectionSize(t)
+}
+
+// DifferenceSize returns the size of the difference of s and t, i.e., s - t,
+// without computing the difference.
+func (s *BitSet[T]) DifferenceSize(t *BitSet[T]) int {
+	return s.Size() - s.IntersectionSize(t)
+}
+
+// Jaccard returns the Jaccard index of s and t, i.e., |s ⋂ t| / |s ⋃ t|.
+// The Jaccard index of two empty sets is 1.
+func (s *BitSet[T]) Jaccard(t *BitSet[T]) float64 {
+	return jaccard(s.IntersectionSize(t), s.Size(), t.Size())
+}
+
+// SorensenDice returns the Sørensen–Dice coefficient of s and t,
+// i.e., 2|s ⋂ t| / (|s| + |t|).
+// The Sørensen–Dice coefficient of two empty sets is 1.
+func (s *BitSet[T]) SorensenDice(t *BitSet[T]) float64 {
+	return sorensenDice(s.IntersectionSize(t), s.Size(), t.Size())
+}
+
+// OverlapCoefficient returns the overlap coefficient of s and t,
+// i.e., |s ⋂ t| / min(|s|, |t|).
+// The overlap coefficient of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s *BitSet[T]) OverlapCoefficient(t *BitSet[T]) float64 {
+	return overlapCoefficient(s.IntersectionSize(t), s.Size(), t.Size())
+}
+
+// CosineSimilarity returns the cosine similarity of s and t,
+// i.e., |s ⋂ t| / √(|s||t|).
+// The cosine similarity of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s *BitSet[T]) CosineSimilarity(t *BitSet[T]) float64 {
+	return cosineSimilarity(s.IntersectionSize(t), s.Size(), t.Size())
+}
+
+// Filter returns a new set containing the elements of s for which pred
+// returns true.
+func (s *BitSet[T]) Filter(pred func(elem T) bool) *BitSet[T] {
+	r := s.Clone()
+	r.Retain(pred)
+	return r
+}
+
+// Retain removes the elements of s for which pred returns false.
+func (s *BitSet[T]) Retain(pred func(elem T) bool) {
+	s.ascend(func(e T) bool {
+		if !pred(e) {
+			s.Remove(e)
+		}
+		return true
+	})
+}
+
+// RemoveIf removes the elements of s for which pred returns true.
+func (s *BitSet[T]) RemoveIf(pred func(elem T) bool) {
+	s.Retain(func(e T) bool { return !pred(e) })
+}
+
+// Partition returns two new sets containing the elements of s for which pred
+// returns true and false, respectively.
+func (s *BitSet[T]) Partition(pred func(elem T) bool) (in, out *BitSet[T]) {
+	in = s.Filter(pred)
+	return in, s.Difference(in)
+}
+
+// Any indicates whether pred returns true for any element of s.
+// It returns false for an empty set.
+func (s *BitSet[T]) Any(pred func(elem T) bool) bool {
+	return !s.None(pred)
+}
+
+// Every indicates whether pred returns true for all elements of s.
+// It returns true for an empty set.
+func (s *BitSet[T]) Every(pred func(elem T) bool) bool {
+	return s.ascend(pred)
+}
+
+// None indicates whether pred returns false for all elements of s.
+// It returns true for an empty set.
+func (s *BitSet[T]) None(pred func(elem T) bool) bool {
+	return s.ascend(func(e T) bool { return !pred(e) })
+}
+
+// Count returns the number of elements of s for which pred returns true.
+func (s *BitSet[T]) Count(pred func(elem T) bool) int {
+	n := 0
+	s.ascend(func(e T) bool {
+		if pred(e) {
+			n++
+		}
+		return true
+	})
+	return n
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// See Set.MarshalJSON for the encoding.
+func (s *BitSet[T]) MarshalJSON() ([]byte, error) {
+	return marshalElems(s.AsSlice())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It replaces the elements of the set with the decoded ones.
+func (s *BitSet[T]) UnmarshalJSON(data []byte) error {
+	var t Set[T]
+	if err := json.Unmarshal(data, &t); err != nil || t == nil {
+		return err
+	}
+	s.Empty()
+	for e := range t {
+		s.Add(e)
+	}
+	return nil
+}
+
+// NewBitSet returns a new BitSet containing zero or more elements.
+func NewBitSet[T Small](elems ...T) *BitSet[T] {
+	s := &BitSet[T]{}
+	s.Add(elems...)
+	return s
+}
+
+// Bitmap-backed sets of all 8-bit and 16-bit integer types.
+type (
+	// BitInt16Set represents a bitmap-backed set of int16 elements.
+	BitInt16Set = BitSet[int16]
+	// BitInt8Set represents a bitmap-backed set of int8 elements.
+	BitInt8Set = BitSet[int8]
+	// BitUInt16Set represents a bitmap-backed set of uint16 elements.
+	BitUInt16Set = BitSet[uint16]
+	// BitUInt8Set represents a bitmap-backed set of uint8 elements.
+	BitUInt8Set = BitSet[uint8]
+)
+
+// NewBitInt16Set returns a new BitInt16Set containing zero or more elements.
+func NewBitInt16Set(elems ...int16) *BitInt16Set {
+	return NewBitSet(elems...)
+}
+
+// NewBitInt8Set returns a new BitInt8Set containing zero or more elements.
+func NewBitInt8Set(elems ...int8) *BitInt8Set {
+	return NewBitSet(elems...)
+}
+
+// NewBitUInt16Set returns a new BitUInt16Set containing zero or more elements.
+func NewBitUInt16Set(elems ...uint16) *BitUInt16Set {
+	return NewBitSet(elems...)
+}
+
+// NewBitUInt8Set returns a new BitUInt8Set containing zero or more elements.
+func NewBitUInt8Set(elems ...uint8) *BitUInt8Set {
+	return NewBitSet(elems...)
+}
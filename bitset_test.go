@@ -0,0 +1,224 @@
+package menge_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/soroushj/menge"
+)
+
+// TestBitSet compares the results of BitSet with those of Set.
+func TestBitSet(t *testing.T) {
+	sets := [][]int8{{}, {math.MinInt8}, {-1}, {math.MinInt8, -1}, {-1, 0, 64}, {math.MinInt8, -1, 0, 64, math.MaxInt8}}
+	for _, a := range sets {
+		for _, b := range sets {
+			s, u := menge.NewBitInt8Set(a...), menge.NewBitInt8Set(b...)
+			ms, mu := menge.NewSet(a...), menge.NewSet(b...)
+			if got, want := s.Equals(u), ms.Equals(mu); got != want {
+				t.Errorf("%v Equals %v got: %v", a, b, got)
+			}
+			for _, op := range []struct {
+				name string
+				f    func(s, u *menge.BitInt8Set) *menge.BitInt8Set
+				g    func(s, u menge.Set[int8]) menge.Set[int8]
+			}{
+				{"Union", (*menge.BitInt8Set).Union, menge.Set[int8].Union},
+				{"Intersection", (*menge.BitInt8Set).Intersection, menge.Set[int8].Intersection},
+				{"Difference", (*menge.BitInt8Set).Difference, menge.Set[int8].Difference},
+				{"SymmetricDifference", (*menge.BitInt8Set).SymmetricDifference, menge.Set[int8].SymmetricDifference},
+			} {
+				if got, want := op.f(s, u).AsSlice(), op.g(ms, mu).AsSortedSlice(); !reflect.DeepEqual(got, want) {
+					t.Errorf("%v %s %v got: %v", a, op.name, b, got)
+				}
+			}
+			for _, op := range []struct {
+				name string
+				f    func(s, u *menge.BitInt8Set)
+				g    func(s, u menge.Set[int8])
+			}{
+				{"UnionWith", (*menge.BitInt8Set).UnionWith, menge.Set[int8].UnionWith},
+				{"IntersectWith", (*menge.BitInt8Set).IntersectWith, menge.Set[int8].IntersectWith},
+				{"DifferenceWith", (*menge.BitInt8Set).DifferenceWith, menge.Set[int8].DifferenceWith},
+				{"SymmetricDifferenceWith", (*menge.BitInt8Set).SymmetricDifferenceWith, menge.Set[int8].SymmetricDifferenceWith},
+			} {
+				got, want := s.Clone(), ms.Clone()
+				op.f(got, u)
+				op.g(want, mu)
+				if !reflect.DeepEqual(got.AsSlice(), want.AsSortedSlice()) {
+					t.Errorf("%v %s %v got: %v", a, op.name, b, got)
+				}
+			}
+			for _, op := range []struct {
+				name string
+				f    func(s, u *menge.BitInt8Set) bool
+				g    func(s, u menge.Set[int8]) bool
+			}{
+				{"IsSubsetOf", (*menge.BitInt8Set).IsSubsetOf, menge.Set[int8].IsSubsetOf},
+				{"IsProperSubsetOf", (*menge.BitInt8Set).IsProperSubsetOf, menge.Set[int8].IsProperSubsetOf},
+				{"IsSupersetOf", (*menge.BitInt8Set).IsSupersetOf, menge.Set[int8].IsSupersetOf},
+				{"IsProperSupersetOf", (*menge.BitInt8Set).IsProperSupersetOf, menge.Set[int8].IsProperSupersetOf},
+				{"IsDisjointFrom", (*menge.BitInt8Set).IsDisjointFrom, menge.Set[int8].IsDisjointFrom},
+			} {
+				if got, want := op.f(s, u), op.g(ms, mu); got != want {
+					t.Errorf("%v %s %v got: %v", a, op.name, b, got)
+				}
+			}
+			if got, want := s.UnionSize(u)+s.DifferenceSize(u), ms.UnionSize(mu)+ms.DifferenceSize(mu); got != want {
+				t.Errorf("%v sizes %v got: %v", a, b, got)
+			}
+			if got, want := s.Jaccard(u)+s.SorensenDice(u)+s.OverlapCoefficient(u)+s.CosineSimilarity(u),
+				ms.Jaccard(mu)+ms.SorensenDice(mu)+ms.OverlapCoefficient(mu)+ms.CosineSimilarity(mu); got != want {
+				t.Errorf("%v similarity %v got: %v", a, b, got)
+			}
+		}
+		s, ms := menge.NewBitInt8Set(a...), menge.NewSet(a...)
+		if s.Size() != ms.Size() || s.IsEmpty() != ms.IsEmpty() || s.String() != ms.String() {
+			t.Errorf("%v got: %v", a, s)
+		}
+		if !reflect.DeepEqual(s.AsSortedSliceDesc(), ms.AsSortedSliceDesc()) || !reflect.DeepEqual(s.AppendTo([]int8{0}), append([]int8{0}, ms.AsSortedSlice()...)) {
+			t.Errorf("%v slices got: %v", a, s)
+		}
+		neg := func(e int8) bool { return e < 0 }
+		in, out := s.Partition(neg)
+		if !reflect.DeepEqual(in.AsSlice(), ms.Filter(neg).AsSortedSlice()) || in.Size()+out.Size() != s.Size() || !s.Filter(neg).Equals(in) {
+			t.Errorf("%v Partition got: %v %v", a, in, out)
+		}
+		if s.Any(neg) != ms.Any(neg) || s.Every(neg) != ms.Every(neg) || s.None(neg) != ms.None(neg) || s.Count(neg) != ms.Count(neg) {
+			t.Errorf("%v predicates got: %v %v %v %v", a, s.Any(neg), s.Every(neg), s.None(neg), s.Count(neg))
+		}
+		r := s.Clone()
+		r.Retain(neg)
+		if !r.Equals(in) {
+			t.Errorf("%v Retain got: %v", a, r)
+		}
+		r = s.Clone()
+		r.RemoveIf(neg)
+		if !r.Equals(out) {
+			t.Errorf("%v RemoveIf got: %v", a, r)
+		}
+	}
+}
+
+func TestBitSet_Types(t *testing.T) {
+	u8 := menge.NewBitUInt8Set(0, 255, 64)
+	i16 := menge.NewBitInt16Set(math.MaxInt16, math.MinInt16, 0)
+	u16 := menge.NewBitUInt16Set(math.MaxUint16, 0, 1000)
+	got := fmt.Sprint(u8, i16, u16)
+	if want := "{0 64 255} {-32768 0 32767} {0 1000 65535}"; got != want {
+		t.Errorf("got: %s, want: %s", got, want)
+	}
+	if !u16.Has(1000) || u16.Has(999) || !i16.Has(math.MinInt16) {
+		t.Errorf("Has got: %v %v", u16, i16)
+	}
+}
+
+func TestBitSet_Mutations(t *testing.T) {
+	var s menge.BitUInt16Set
+	if !s.IsEmpty() || s.Has(1) || s.Size() != 0 {
+		t.Errorf("zero value got: %v", &s)
+	}
+	var z menge.BitUInt16Set
+	if !s.Equals(&z) || !s.IsSubsetOf(&z) || s.Union(&z).Size() != 0 {
+		t.Errorf("zero values got: %v", &s)
+	}
+	s.Remove(1)
+	s.Add(3, 1, 2)
+	c := s.Clone()
+	s.Remove(2)
+	if got := s.AsSlice(); !reflect.DeepEqual(got, []uint16{1, 3}) || c.Size() != 3 {
+		t.Errorf("Remove got: %v %v", got, c)
+	}
+	s.Empty()
+	if !s.IsEmpty() {
+		t.Errorf("Empty got: %v", &s)
+	}
+}
+
+func TestBitSet_Encoding(t *testing.T) {
+	s := menge.NewBitInt8Set(2, -1)
+	if got := fmt.Sprintf("%v %+v %#v", s, s, s); got != "{-1 2} {-1 2} (size 2) menge.NewBitSet[int8](-1, 2)" {
+		t.Errorf("Format got: %s", got)
+	}
+	data, err := json.Marshal(s)
+	if err != nil || string(data) != `[-1,2]` {
+		t.Errorf("MarshalJSON got: %s %v", data, err)
+	}
+	u := menge.NewBitInt8Set(5)
+	if err := json.Unmarshal([]byte(`[2,1,2]`), u); err != nil || !reflect.DeepEqual(u.AsSlice(), []int8{1, 2}) {
+		t.Errorf("UnmarshalJSON got: %v %v", u, err)
+	}
+	if err := json.Unmarshal([]byte(`[128]`), u); err == nil {
+		t.Errorf("UnmarshalJSON of out of range element got: %v", u)
+	}
+}
+
+// BenchmarkBitSet compares a BitUInt16Set with a UInt16Set holding every
+// other element. The build benchmarks report the memory of the sets.
+func BenchmarkBitSet(b *testing.B) {
+	var elems, others []uint16
+	for i := 0; i < 1<<16; i += 2 {
+		elems = append(elems, uint16(i))
+		others = append(others, uint16(i+i%4))
+	}
+	b.Run("Build/Map", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			menge.NewUInt16Set(elems...)
+		}
+	})
+	b.Run("Build/Bitmap", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			menge.NewBitUInt16Set(elems...)
+		}
+	})
+	ms, mu := menge.NewUInt16Set(elems...), menge.NewUInt16Set(others...)
+	bs, bu := menge.NewBitUInt16Set(elems...), menge.NewBitUInt16Set(others...)
+	b.Run("Has/Map", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ms.Has(uint16(i))
+		}
+	})
+	b.Run("Has/Bitmap", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			bs.Has(uint16(i))
+		}
+	})
+	b.Run("Size/Map", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ms.Size()
+		}
+	})
+	b.Run("Size/Bitmap", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			bs.Size()
+		}
+	})
+	b.Run("Union/Map", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			ms.Union(mu)
+		}
+	})
+	b.Run("Union/Bitmap", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			bs.Union(bu)
+		}
+	})
+	b.Run("Intersection/Map", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			ms.Intersection(mu)
+		}
+	})
+	b.Run("Intersection/Bitmap", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			bs.Intersection(bu)
+		}
+	})
+}
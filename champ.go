@@ -0,0 +1,285 @@
+package menge
+
+import (
+	"math"
+	"math/bits"
+	"reflect"
+)
+
+// champ is a node of a compressed hash-array mapped prefix trie (CHAMP), an
+// immutable hash trie that is updated by copying the path to the updated
+// node, so that the versions of a trie share their unchanged nodes.
+// Each level of the trie consumes champBits bits of the hashes of the
+// elements. An element is stored inline in elems, at the position of its bit
+// in datamap, until another element with the same bits is added, at which
+// point both move to a child node in nodes, at the position of their bit in
+// nodemap. Elements whose hashes are equal end up in a collision node past
+// the last level, which only has elems.
+// A removal moves the last element of a child node up to its parent, so that
+// a trie has a single shape for a given set of elements.
+// A nil *champ is an empty trie.
+type champ[T comparable] struct {
+	datamap, nodemap uint32
+	elems            []T
+	nodes            []*champ[T]
+}
+
+// champBits is the number of bits of the hash consumed by each level.
+const champBits = 5
+
+// isCollision indicates whether the node at shift is a collision node.
+func isCollision(shift uint) bool {
+	return shift >= 64
+}
+
+// bitpos returns the bit of the hash h at shift in datamap and nodemap.
+func bitpos(h uint64, shift uint) uint32 {
+	return 1 << (h >> shift & (1<<champBits - 1))
+}
+
+// index returns the index of bit in the slice whose elements are marked by
+// bitmap.
+func index(bitmap, bit uint32) int {
+	return bits.OnesCount32(bitmap & (bit - 1))
+}
+
+// has indicates whether the trie rooted at n at shift has e, whose hash is h.
+func (n *champ[T]) has(e T, h uint64, shift uint) bool {
+	for n != nil {
+		if isCollision(shift) {
+			for _, x := range n.elems {
+				if x == e {
+					return true
+				}
+			}
+			return false
+		}
+		bit := bitpos(h, shift)
+		if n.datamap&bit != 0 {
+			return n.elems[index(n.datamap, bit)] == e
+		}
+		if n.nodemap&bit == 0 {
+			return false
+		}
+		n = n.nodes[index(n.nodemap, bit)]
+		shift += champBits
+	}
+	return false
+}
+
+// copy returns a shallow copy of n with its own slices.
+func (n *champ[T]) copy() *champ[T] {
+	if n == nil {
+		return &champ[T]{}
+	}
+	return &champ[T]{
+		datamap: n.datamap,
+		nodemap: n.nodemap,
+		elems:   append([]T(nil), n.elems...),
+		nodes:   append([]*champ[T](nil), n.nodes...),
+	}
+}
+
+// with returns the trie rooted at n at shift with e, whose hash is h,
+// and whether e was added. n is returned if it already has e.
+func (n *champ[T]) with(e T, h uint64, shift uint) (*champ[T], bool) {
+	if isCollision(shift) {
+		if n.has(e, h, shift) {
+			return n, false
+		}
+		c := n.copy()
+		c.elems = append(c.elems, e)
+		return c, true
+	}
+	bit := bitpos(h, shift)
+	switch {
+	case n != nil && n.datamap&bit != 0:
+		i := index(n.datamap, bit)
+		x := n.elems[i]
+		if x == e {
+			return n, false
+		}
+		c := n.copy()
+		c.datamap &^= bit
+		c.elems = removeAt(c.elems, i)
+		c.nodemap |= bit
+		c.nodes = insertAt(c.nodes, index(c.nodemap, bit), pair(x, elemHash(x), e, h, shift+champBits))
+		return c, true
+	case n != nil && n.nodemap&bit != 0:
+		i := index(n.nodemap, bit)
+		child, added := n.nodes[i].with(e, h, shift+champBits)
+		if !added {
+			return n, false
+		}
+		c := n.copy()
+		c.nodes[i] = child
+		return c, true
+	}
+	c := n.copy()
+	c.datamap |= bit
+	c.elems = insertAt(c.elems, index(c.datamap, bit), e)
+	return c, true
+}
+
+// pair returns a trie at shift with the distinct elements a and b, whose
+// hashes are ha and hb.
+func pair[T comparable](a T, ha uint64, b T, hb uint64, shift uint) *champ[T] {
+	if isCollision(shift) {
+		return &champ[T]{elems: []T{a, b}}
+	}
+	ba, bb := bitpos(ha, shift), bitpos(hb, shift)
+	if ba == bb {
+		return &champ[T]{nodemap: ba, nodes: []*champ[T]{pair(a, ha, b, hb, shift+champBits)}}
+	}
+	if bb < ba {
+		a, b = b, a
+	}
+	return &champ[T]{datamap: ba | bb, elems: []T{a, b}}
+}
+
+// without returns the trie rooted at n at shift without e, whose hash is h,
+// and whether e was removed. n is returned if it does not have e.
+func (n *champ[T]) without(e T, h uint64, shift uint) (*champ[T], bool) {
+	if n == nil {
+		return nil, false
+	}
+	if isCollision(shift) {
+		for i, x := range n.elems {
+			if x == e {
+				c := n.copy()
+				c.elems = removeAt(c.elems, i)
+				return c, true
+			}
+		}
+		return n, false
+	}
+	bit := bitpos(h, shift)
+	switch {
+	case n.datamap&bit != 0:
+		i := index(n.datamap, bit)
+		if n.elems[i] != e {
+			return n, false
+		}
+		c := n.copy()
+		c.datamap &^= bit
+		c.elems = removeAt(c.elems, i)
+		return c, true
+	case n.nodemap&bit != 0:
+		i := index(n.nodemap, bit)
+		child, removed := n.nodes[i].without(e, h, shift+champBits)
+		if !removed {
+			return n, false
+		}
+		c := n.copy()
+		if child.nodemap == 0 && len(child.elems) == 1 {
+			// Move the last element of the child up.
+			c.nodemap &^= bit
+			c.nodes = removeAt(c.nodes, i)
+			c.datamap |= bit
+			c.elems = insertAt(c.elems, index(c.datamap, bit), child.elems[0])
+		} else {
+			c.nodes[i] = child
+		}
+		return c, true
+	}
+	return n, false
+}
+
+// each calls f for each element of the trie rooted at n, in the order of
+// their hashes, until f returns false, and reports whether f never returned
+// false.
+func (n *champ[T]) each(f func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+	for _, e := range n.elems {
+		if !f(e) {
+			return false
+		}
+	}
+	for _, c := range n.nodes {
+		if !c.each(f) {
+			return false
+		}
+	}
+	return true
+}
+
+// insertAt inserts e at index i of a, which must not be shared.
+func insertAt[E any](a []E, i int, e E) []E {
+	a = append(a, e)
+	copy(a[i+1:], a[i:])
+	a[i] = e
+	return a
+}
+
+// removeAt removes the element at index i of a, which must not be shared.
+func removeAt[E any](a []E, i int) []E {
+	return append(a[:i], a[i+1:]...)
+}
+
+// elemHash returns a hash of e such that equal elements have equal hashes.
+// T must be a basic type other than bool, or a type whose underlying type
+// is one.
+func elemHash[T comparable](e T) uint64 {
+	switch e := any(e).(type) {
+	case int:
+		return mix64(uint64(e))
+	case int8:
+		return mix64(uint64(e))
+	case int16:
+		return mix64(uint64(e))
+	case int32:
+		return mix64(uint64(e))
+	case int64:
+		return mix64(uint64(e))
+	case uint:
+		return mix64(uint64(e))
+	case uint8:
+		return mix64(uint64(e))
+	case uint16:
+		return mix64(uint64(e))
+	case uint32:
+		return mix64(uint64(e))
+	case uint64:
+		return mix64(e)
+	case uintptr:
+		return mix64(uint64(e))
+	case float32:
+		return floatHash(float64(e))
+	case float64:
+		return floatHash(e)
+	case complex64:
+		return complexHash(complex128(e))
+	case complex128:
+		return complexHash(e)
+	case string:
+		return stringHash(e)
+	}
+	// Named types are hashed by kind.
+	v := reflect.ValueOf(e)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return mix64(uint64(v.Int()))
+	case reflect.Float32, reflect.Float64:
+		return floatHash(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		return complexHash(v.Complex())
+	case reflect.String:
+		return stringHash(v.String())
+	}
+	return mix64(v.Uint())
+}
+
+// floatHash returns a hash of f. -0 and +0 have the same hash.
+func floatHash(f float64) uint64 {
+	if f == 0 {
+		f = 0
+	}
+	return mix64(math.Float64bits(f))
+}
+
+// complexHash returns a hash of c.
+func complexHash(c complex128) uint64 {
+	return mix64(floatHash(real(c)) ^ bits.RotateLeft64(floatHash(imag(c)), 32))
+}
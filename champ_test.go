@@ -0,0 +1,89 @@
+package menge
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestChamp_Collisions(t *testing.T) {
+	// Elements with equal hashes end up in a collision node past the last
+	// level, and move back up as they are removed.
+	n := pair(1, 0, 2, 0, 0)
+	n, added := n.with(3, 0, 0)
+	if !added || !n.has(1, 0, 0) || !n.has(2, 0, 0) || !n.has(3, 0, 0) || n.has(4, 0, 0) {
+		t.Fatalf("with got: %v", n)
+	}
+	if _, added := n.with(2, 0, 0); added {
+		t.Errorf("with of existing element got: true")
+	}
+	for _, e := range []int{2, 1} {
+		var removed bool
+		if n, removed = n.without(e, 0, 0); !removed || n.has(e, 0, 0) {
+			t.Fatalf("without(%d) got: %v", e, removed)
+		}
+	}
+	if n.nodemap != 0 || !reflect.DeepEqual(n.elems, []int{3}) {
+		t.Errorf("without got: %+v", n)
+	}
+}
+
+// sameShape indicates whether the tries rooted at a and b have the same
+// nodes with the same elements.
+func sameShape[T comparable](a, b *champ[T]) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.datamap != b.datamap || a.nodemap != b.nodemap || len(a.elems) != len(b.elems) || len(a.nodes) != len(b.nodes) {
+		return false
+	}
+	for i := range a.elems {
+		if a.elems[i] != b.elems[i] {
+			return false
+		}
+	}
+	for i := range a.nodes {
+		if !sameShape(a.nodes[i], b.nodes[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestPersistentSet_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	s, m := PersistentSet[int]{}, make(Set[int])
+	versions := []PersistentSet[int]{s}
+	sets := []Set[int]{m.Clone()}
+	for i := 0; i < 5000; i++ {
+		e := r.Intn(2000)
+		if r.Intn(3) == 0 {
+			s = s.Without(e)
+			m.Remove(e)
+		} else {
+			s = s.With(e)
+			m.Add(e)
+		}
+		if i%500 == 0 {
+			versions, sets = append(versions, s), append(sets, m.Clone())
+		}
+	}
+	// Older versions are not affected by later updates.
+	for i, v := range versions {
+		if !reflect.DeepEqual(v.ToSet(), sets[i]) || v.Size() != sets[i].Size() {
+			t.Fatalf("version %d got size: %d, want: %d", i, v.Size(), sets[i].Size())
+		}
+	}
+	// The shape of a trie depends only on its elements.
+	a := m.AsSortedSlice()
+	u := NewPersistentSet(a...)
+	for i, j := 0, len(a)-1; i < j; i, j = i+1, j-1 {
+		a[i], a[j] = a[j], a[i]
+	}
+	if !sameShape(s.root, u.root) || !sameShape(NewPersistentSet(a...).root, u.root) {
+		t.Errorf("tries of the same elements differ")
+	}
+	if s = s.Without(a...); s.root != nil || s.Size() != 0 {
+		t.Errorf("Without got: %v", s)
+	}
+}
@@ -0,0 +1,392 @@
+// Mengegen generates a set type for a comparable element type.
+//
+// The generated type has the same shape as the set types of package menge,
+// e.g., IntSet, and can optionally be accompanied by a test file.
+// Mengegen is meant to be run by go generate:
+//
+//	//go:generate go run github.com/soroushj/menge/cmd/mengegen -type UserID
+//
+// Usage:
+//
+//	mengegen -type T [flags]
+//
+// The flags are:
+//
+//	-type T
+//		The element type, e.g., int, UserID, or netip.Addr. Required.
+//	-name N
+//		The name of the set type. Defaults to the name of the element type,
+//		without its package qualifier, followed by Set, e.g., UserIDSet.
+//	-unexported
+//		Make the set type and its constructor unexported.
+//	-package P
+//		The package of the generated files. Defaults to $GOPACKAGE.
+//	-import PATH
+//		The import path of the package of the element type, if any.
+//	-nan
+//		Ignore NaN values on Add, as Float64Set does.
+//		The element type must be a floating-point type, complex64, or
+//		complex128. A complex value is NaN if either of its parts is.
+//	-generic
+//		Define the set type over menge.Set instead of generating a
+//		self-contained implementation. Requires Go 1.18 or later.
+//	-binary
+//		Implement encoding.BinaryMarshaler and encoding.BinaryUnmarshaler
+//		with the compact encoding of menge.Set. The element type must be an
+//		integer, floating-point, complex, or string type. Requires -generic.
+//	-iter
+//		Also generate a file with range-over-func iterators next to the
+//		output file, e.g., useridset_iter.go, built only by Go 1.23 or
+//		later. Requires -generic.
+//	-output FILE
+//		The output file. Defaults to the lower-cased name of the set type
+//		followed by .go, e.g., useridset.go.
+//	-test
+//		Also generate a test file next to the output file.
+//	-value V
+//		A Go expression for a sample element used by the test file.
+//		Must be given three times with distinct values. Defaults to 1, 2, 3.
+package main
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+	"unicode"
+	"unicode/utf8"
+)
+
+const mengePath = "github.com/soroushj/menge"
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+var templates = template.Must(template.ParseFS(templateFS, "templates/*.tmpl"))
+
+// config holds the options of a single run.
+type config struct {
+	elem       string
+	name       string
+	unexported bool
+	pkg        string
+	importPath string
+	nan        bool
+	generic    bool
+	binary     bool
+	iter       bool
+	output     string
+	test       bool
+	values     []string
+}
+
+// file is a generated file.
+type file struct {
+	name string
+	src  []byte
+}
+
+// templateData is the data passed to the templates.
+type templateData struct {
+	Package  string
+	Build    string
+	Imports  string
+	Name     string
+	New      string
+	Collect  string
+	TestName string
+	TestNew  string
+	Elem     string
+	Set      string
+	Pkg      string
+	Internal bool
+	NaN      bool
+	Binary   bool
+	NaNCheck string
+	NaNValue string
+	A, B, C  string
+}
+
+// Func returns the name of the package-level function with the given prefix
+// for the set type, e.g., UnionInt for Union and IntSet.
+func (d templateData) Func(prefix string) string {
+	name := prefix + upperFirst(strings.TrimSuffix(d.Name, "Set"))
+	if d.New[0] == 'n' {
+		return lowerFirst(name)
+	}
+	return name
+}
+
+// Type returns the name of the package-level type with the given suffix
+// for the set type, e.g., IntReader for Reader and IntSet.
+func (d templateData) Type(suffix string) string {
+	name := strings.TrimSuffix(d.Name, "Set") + suffix
+	if d.New[0] == 'n' {
+		return lowerFirst(name)
+	}
+	return name
+}
+
+// Frozen returns the name of the read-only view type of the set type,
+// e.g., FrozenIntSet for IntSet.
+func (d templateData) Frozen() string {
+	name := "Frozen" + upperFirst(d.Name)
+	if d.New[0] == 'n' {
+		return lowerFirst(name)
+	}
+	return name
+}
+
+type valuesFlag []string
+
+func (v *valuesFlag) String() string {
+	return strings.Join(*v, ", ")
+}
+
+func (v *valuesFlag) Set(s string) error {
+	*v = append(*v, s)
+	return nil
+}
+
+func main() {
+	cfg, err := parseArgs(os.Args[1:], os.Getenv("GOPACKAGE"), os.Stderr)
+	if err != nil {
+		if err == flag.ErrHelp {
+			os.Exit(0)
+		}
+		fmt.Fprintln(os.Stderr, "mengegen:", err)
+		os.Exit(2)
+	}
+	files, err := generate(cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "mengegen:", err)
+		os.Exit(1)
+	}
+	for _, f := range files {
+		if err := os.WriteFile(f.name, f.src, 0o644); err != nil {
+			fmt.Fprintln(os.Stderr, "mengegen:", err)
+			os.Exit(1)
+		}
+	}
+}
+
+// parseArgs parses the command-line arguments into a config.
+// pkg is the default package name.
+func parseArgs(args []string, pkg string, output io.Writer) (*config, error) {
+	cfg := &config{}
+	var values valuesFlag
+	fs := flag.NewFlagSet("mengegen", flag.ContinueOnError)
+	fs.SetOutput(output)
+	fs.StringVar(&cfg.elem, "type", "", "element type")
+	fs.StringVar(&cfg.name, "name", "", "set type name")
+	fs.BoolVar(&cfg.unexported, "unexported", false, "make the set type unexported")
+	fs.StringVar(&cfg.pkg, "package", pkg, "package name")
+	fs.StringVar(&cfg.importPath, "import", "", "import path of the element type")
+	fs.BoolVar(&cfg.nan, "nan", false, "ignore NaN values")
+	fs.BoolVar(&cfg.generic, "generic", false, "define the set type over menge.Set")
+	fs.BoolVar(&cfg.binary, "binary", false, "implement the binary marshaler interfaces")
+	fs.BoolVar(&cfg.iter, "iter", false, "also generate iterators")
+	fs.StringVar(&cfg.output, "output", "", "output file")
+	fs.BoolVar(&cfg.test, "test", false, "also generate a test file")
+	fs.Var(&values, "value", "sample element for tests (three times)")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() != 0 {
+		return nil, fmt.Errorf("unexpected arguments: %v", fs.Args())
+	}
+	if cfg.elem == "" {
+		return nil, errors.New("-type is required")
+	}
+	if cfg.pkg == "" {
+		return nil, errors.New("-package is required outside go generate")
+	}
+	if !token.IsIdentifier(cfg.pkg) {
+		return nil, fmt.Errorf("invalid package name %q", cfg.pkg)
+	}
+	if cfg.name == "" {
+		base := cfg.elem[strings.LastIndex(cfg.elem, ".")+1:]
+		cfg.name = upperFirst(base) + "Set"
+	}
+	if cfg.unexported {
+		cfg.name = lowerFirst(cfg.name)
+	}
+	if !token.IsIdentifier(cfg.name) {
+		return nil, fmt.Errorf("invalid set type name %q", cfg.name)
+	}
+	if cfg.iter && !cfg.generic {
+		return nil, errors.New("-iter requires -generic")
+	}
+	if cfg.binary && !cfg.generic {
+		return nil, errors.New("-binary requires -generic")
+	}
+	if cfg.output == "" {
+		cfg.output = strings.ToLower(cfg.name) + ".go"
+	}
+	if !strings.HasSuffix(cfg.output, ".go") || strings.HasSuffix(cfg.output, "_test.go") {
+		return nil, fmt.Errorf("invalid output file %q", cfg.output)
+	}
+	switch len(values) {
+	case 0:
+		cfg.values = []string{"1", "2", "3"}
+	case 3:
+		cfg.values = values
+	default:
+		return nil, errors.New("-value must be given three times")
+	}
+	return cfg, nil
+}
+
+// generate generates the set file and, if requested, the iterator and test
+// files.
+func generate(cfg *config) ([]file, error) {
+	d := templateData{
+		Package:  cfg.pkg,
+		Name:     cfg.name,
+		New:      "New" + upperFirst(cfg.name),
+		Collect:  "Collect" + upperFirst(cfg.name),
+		Elem:     cfg.elem,
+		NaN:      cfg.nan,
+		Binary:   cfg.binary,
+		NaNCheck: "math.IsNaN(float64(e))",
+		NaNValue: cfg.elem + "(math.NaN())",
+		A:        cfg.values[0],
+		B:        cfg.values[1],
+		C:        cfg.values[2],
+	}
+	d.TestName, d.TestNew = d.Name, d.New
+	if cfg.unexported {
+		d.New = "new" + upperFirst(cfg.name)
+		d.Collect = "collect" + upperFirst(cfg.name)
+		d.TestName, d.TestNew = "_"+d.Name, "_"+d.New
+	}
+	switch cfg.elem {
+	case "float64":
+		d.NaNCheck = "math.IsNaN(e)"
+		d.NaNValue = "math.NaN()"
+	case "complex64":
+		d.NaNCheck = "(math.IsNaN(float64(real(e))) || math.IsNaN(float64(imag(e))))"
+		d.NaNValue = "complex(float32(math.NaN()), 0)"
+	case "complex128":
+		d.NaNCheck = "(math.IsNaN(real(e)) || math.IsNaN(imag(e)))"
+		d.NaNValue = "complex(math.NaN(), 0)"
+	}
+	var std, other []string
+	if cfg.importPath != "" {
+		other = append(other, cfg.importPath)
+	}
+	if cfg.nan {
+		std = append(std, "math")
+	}
+	var tmpl string
+	if cfg.generic {
+		tmpl = "generic.go.tmpl"
+		std = append(std, "fmt")
+		d.Set = "Set[" + cfg.elem + "]"
+		d.Internal = cfg.pkg == "menge"
+		if !d.Internal {
+			d.Pkg = "menge."
+			d.Set = d.Pkg + d.Set
+			other = append(other, mengePath)
+		}
+	} else {
+		tmpl = "set.go.tmpl"
+		std = append(std, "fmt", "strings")
+	}
+	d.Imports = importDecl(std, other)
+	src, err := execute(tmpl, d)
+	if err != nil {
+		return nil, err
+	}
+	files := []file{{cfg.output, src}}
+	if cfg.iter {
+		other = nil
+		if cfg.importPath != "" {
+			other = append(other, cfg.importPath)
+		}
+		if !d.Internal {
+			other = append(other, mengePath)
+		}
+		it := d
+		it.Build = "go1.23"
+		it.Imports = importDecl([]string{"iter"}, other)
+		src, err := execute("iter.go.tmpl", it)
+		if err != nil {
+			return nil, err
+		}
+		name := strings.TrimSuffix(cfg.output, ".go") + "_iter.go"
+		files = append(files, file{name, src})
+	}
+	if cfg.test {
+		std = []string{"fmt", "testing"}
+		if cfg.nan {
+			std = append(std, "math")
+		}
+		other = nil
+		if cfg.importPath != "" {
+			other = append(other, cfg.importPath)
+		}
+		d.Imports = importDecl(std, other)
+		src, err := execute("test.go.tmpl", d)
+		if err != nil {
+			return nil, err
+		}
+		name := strings.TrimSuffix(cfg.output, ".go") + "_test.go"
+		files = append(files, file{name, src})
+	}
+	return files, nil
+}
+
+// execute executes a template and formats the result.
+func execute(name string, d templateData) ([]byte, error) {
+	var b bytes.Buffer
+	if err := templates.ExecuteTemplate(&b, name, d); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting %s: %v", name, err)
+	}
+	return src, nil
+}
+
+// importDecl returns an import declaration for the standard library
+// packages std and the other packages other, or "" if there are none.
+func importDecl(std, other []string) string {
+	switch {
+	case len(std)+len(other) == 0:
+		return ""
+	case len(std)+len(other) == 1:
+		return fmt.Sprintf("\nimport %q\n", append(std, other...)[0])
+	}
+	b := &strings.Builder{}
+	b.WriteString("\nimport (\n")
+	for _, p := range std {
+		fmt.Fprintf(b, "\t%q\n", p)
+	}
+	if len(std) != 0 && len(other) != 0 {
+		b.WriteString("\n")
+	}
+	for _, p := range other {
+		fmt.Fprintf(b, "\t%q\n", p)
+	}
+	b.WriteString(")\n")
+	return b.String()
+}
+
+func upperFirst(s string) string {
+	r, n := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[n:]
+}
+
+func lowerFirst(s string) string {
+	r, n := utf8.DecodeRuneInString(s)
+	return string(unicode.ToLower(r)) + s[n:]
+}
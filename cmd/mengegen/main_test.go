@@ -0,0 +1,154 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// root is the root directory of the module.
+const root = "../.."
+
+func TestParseArgs(t *testing.T) {
+	cases := []struct {
+		args       []string
+		wantName   string
+		wantOutput string
+	}{
+		{[]string{"-type", "int"}, "IntSet", "intset.go"},
+		{[]string{"-type", "netip.Addr", "-import", "net/netip"}, "AddrSet", "addrset.go"},
+		{[]string{"-type", "UserID", "-unexported"}, "userIDSet", "useridset.go"},
+		{[]string{"-type", "int", "-name", "IDs", "-output", "ids.go"}, "IDs", "ids.go"},
+	}
+	for _, c := range cases {
+		got, err := parseArgs(c.args, "p", io.Discard)
+		if err != nil {
+			t.Errorf("case: %v error: %v", c, err)
+			continue
+		}
+		if got.name != c.wantName || got.output != c.wantOutput {
+			t.Errorf("case: %v got: %v %v", c, got.name, got.output)
+		}
+	}
+}
+
+func TestParseArgs_Errors(t *testing.T) {
+	cases := [][]string{
+		{},
+		{"-name", "IntSet"},
+		{"-type", "int", "-package", ""},
+		{"-type", "int", "-name", "Int Set"},
+		{"-type", "int", "-output", "int_test.go"},
+		{"-type", "int", "-value", "1"},
+		{"-type", "int", "extra"},
+		{"-type", "int", "-iter"},
+		{"-type", "int", "-binary"},
+	}
+	for _, c := range cases {
+		if _, err := parseArgs(c, "p", io.Discard); err == nil {
+			t.Errorf("case: %v got no error", c)
+		}
+	}
+}
+
+// TestGenerate_UpToDate checks that the set types of package menge are
+// identical to what their go:generate directives produce.
+func TestGenerate_UpToDate(t *testing.T) {
+	f, err := os.Open(filepath.Join(root, "gen.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	const prefix = "//go:generate go run ./cmd/mengegen "
+	n := 0
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := sc.Text()
+		if !strings.HasPrefix(line, prefix) {
+			continue
+		}
+		n++
+		cfg, err := parseArgs(strings.Fields(strings.TrimPrefix(line, prefix)), "menge", io.Discard)
+		if err != nil {
+			t.Fatalf("%s: %v", line, err)
+		}
+		files, err := generate(cfg)
+		if err != nil {
+			t.Fatalf("%s: %v", line, err)
+		}
+		for _, f := range files {
+			got, err := os.ReadFile(filepath.Join(root, f.name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, f.src) {
+				t.Errorf("%s is out of date; run go generate", f.name)
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n != 16 {
+		t.Errorf("got %d directives, want 16", n)
+	}
+}
+
+// TestGenerate_Test generates set types with their tests in a temporary
+// module and runs the tests.
+func TestGenerate_Test(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	write := func(name, src string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("go.mod", "module example.com/gen\n\ngo 1.18\n\nrequire "+mengePath+" v0.0.0\n\nreplace "+mengePath+" => "+abs+"\n")
+	write("types.go", "package gen\n\ntype userID int\n\ntype celsius float32\n\ntype point struct{ x, y int }\n")
+	runs := [][]string{
+		{"-type", "int", "-name", "IntSet"},
+		{"-type", "string", "-value", `"a"`, "-value", `"b"`, "-value", `"c"`},
+		{"-type", "float64", "-nan"},
+		{"-type", "complex64", "-nan", "-generic", "-binary"},
+		{"-type", "celsius", "-nan", "-unexported"},
+		{"-type", "celsius", "-name", "CelsiusGenericSet", "-nan", "-generic"},
+		{"-type", "userID", "-generic", "-binary", "-iter"},
+		{"-type", "point", "-value", "point{1, 1}", "-value", "point{2, 2}", "-value", "point{3, 3}"},
+		{"-type", "netip.Addr", "-import", "net/netip", "-generic", "-iter", "-value", `netip.MustParseAddr("::1")`, "-value", `netip.MustParseAddr("::2")`, "-value", `netip.MustParseAddr("::3")`},
+	}
+	for _, args := range runs {
+		cfg, err := parseArgs(append(args, "-test"), "gen", io.Discard)
+		if err != nil {
+			t.Fatalf("%v: %v", args, err)
+		}
+		files, err := generate(cfg)
+		if err != nil {
+			t.Fatalf("%v: %v", args, err)
+		}
+		for _, f := range files {
+			write(f.name, string(f.src))
+		}
+	}
+	cmd := exec.Command(gobin, "test", "-mod=mod", ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=", "GOPROXY=off")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("go test: %v\n%s", err, out)
+	}
+}
@@ -0,0 +1,30 @@
+// Code generated by mengegen. DO NOT EDIT.
+
+//go:build go1.23
+
+package menge
+
+import "iter"
+
+// All returns an iterator over the elements of the set,
+// with no specific order of the elements.
+func (s Complex128Set) All() iter.Seq[complex128] {
+	return Set[complex128](s).All()
+}
+
+// Sorted returns an iterator over the elements of the set,
+// in their natural order, as used by String.
+// The elements are sorted when the iteration starts.
+func (s Complex128Set) Sorted() iter.Seq[complex128] {
+	return Set[complex128](s).Sorted()
+}
+
+// CollectComplex128Set returns a new Complex128Set containing the elements of seq.
+// NaN values are ignored, as they are by Add.
+func CollectComplex128Set(seq iter.Seq[complex128]) Complex128Set {
+	s := NewComplex128Set()
+	for e := range seq {
+		s.Add(e)
+	}
+	return s
+}
@@ -0,0 +1,30 @@
+// Code generated by mengegen. DO NOT EDIT.
+
+//go:build go1.23
+
+package menge
+
+import "iter"
+
+// All returns an iterator over the elements of the set,
+// with no specific order of the elements.
+func (s Complex64Set) All() iter.Seq[complex64] {
+	return Set[complex64](s).All()
+}
+
+// Sorted returns an iterator over the elements of the set,
+// in their natural order, as used by String.
+// The elements are sorted when the iteration starts.
+func (s Complex64Set) Sorted() iter.Seq[complex64] {
+	return Set[complex64](s).Sorted()
+}
+
+// CollectComplex64Set returns a new Complex64Set containing the elements of seq.
+// NaN values are ignored, as they are by Add.
+func CollectComplex64Set(seq iter.Seq[complex64]) Complex64Set {
+	s := NewComplex64Set()
+	for e := range seq {
+		s.Add(e)
+	}
+	return s
+}
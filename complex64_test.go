@@ -1,371 +0,0 @@
-package menge_test
-
-import (
-	"testing"
-
-	"github.com/soroushj/menge"
-)
-
-func TestNewComplex64Set(t *testing.T) {
-	cases := []struct {
-		arg  []complex64
-		want menge.Complex64Set
-	}{
-		{[]complex64{}, menge.Complex64Set{}},
-		{[]complex64{1, 1}, menge.Complex64Set{1: struct{}{}}},
-		{[]complex64{1, 2}, menge.Complex64Set{1: struct{}{}, 2: struct{}{}}},
-	}
-	for _, c := range cases {
-		got := menge.NewComplex64Set(c.arg...)
-		if !got.Equals(c.want) {
-			t.Errorf("case: %v got: %v", c, got)
-		}
-	}
-}
-
-func TestComplex64Set_Add(t *testing.T) {
-	cases := []struct {
-		set  menge.Complex64Set
-		arg  []complex64
-		want menge.Complex64Set
-	}{
-		{menge.NewComplex64Set(), []complex64{}, menge.NewComplex64Set()},
-		{menge.NewComplex64Set(), []complex64{1, 1}, menge.NewComplex64Set(1)},
-		{menge.NewComplex64Set(), []complex64{1, 2}, menge.NewComplex64Set(1, 2)},
-		{menge.NewComplex64Set(1), []complex64{}, menge.NewComplex64Set(1)},
-		{menge.NewComplex64Set(1), []complex64{1, 1}, menge.NewComplex64Set(1)},
-		{menge.NewComplex64Set(1), []complex64{2, 3}, menge.NewComplex64Set(1, 2, 3)},
-	}
-	for _, c := range cases {
-		got := c.set.Clone()
-		got.Add(c.arg...)
-		if !got.Equals(c.want) {
-			t.Errorf("case: %v got: %v", c, got)
-		}
-	}
-}
-
-func TestComplex64Set_Remove(t *testing.T) {
-	cases := []struct {
-		set  menge.Complex64Set
-		arg  []complex64
-		want menge.Complex64Set
-	}{
-		{menge.NewComplex64Set(), []complex64{}, menge.NewComplex64Set()},
-		{menge.NewComplex64Set(1), []complex64{1, 1}, menge.NewComplex64Set()},
-		{menge.NewComplex64Set(1, 2), []complex64{1, 2}, menge.NewComplex64Set()},
-		{menge.NewComplex64Set(1), []complex64{}, menge.NewComplex64Set(1)},
-		{menge.NewComplex64Set(1), []complex64{1, 1}, menge.NewComplex64Set()},
-		{menge.NewComplex64Set(1, 2), []complex64{3}, menge.NewComplex64Set(1, 2)},
-		{menge.NewComplex64Set(1, 2, 3), []complex64{2, 3}, menge.NewComplex64Set(1)},
-	}
-	for _, c := range cases {
-		got := c.set.Clone()
-		got.Remove(c.arg...)
-		if !got.Equals(c.want) {
-			t.Errorf("case: %v got: %v", c, got)
-		}
-	}
-}
-
-func TestComplex64Set_Empty(t *testing.T) {
-	cases := []struct {
-		set  menge.Complex64Set
-		want menge.Complex64Set
-	}{
-		{menge.NewComplex64Set(), menge.NewComplex64Set()},
-		{menge.NewComplex64Set(1, 2), menge.NewComplex64Set()},
-	}
-	for _, c := range cases {
-		got := c.set.Clone()
-		got.Empty()
-		if !got.Equals(c.want) {
-			t.Errorf("case: %v got: %v", c, got)
-		}
-	}
-}
-
-func TestComplex64Set_Has(t *testing.T) {
-	cases := []struct {
-		set  menge.Complex64Set
-		arg  complex64
-		want bool
-	}{
-		{menge.NewComplex64Set(), 1, false},
-		{menge.NewComplex64Set(2), 1, false},
-		{menge.NewComplex64Set(1), 1, true},
-		{menge.NewComplex64Set(1, 2), 1, true},
-	}
-	for _, c := range cases {
-		got := c.set.Has(c.arg)
-		if got != c.want {
-			t.Errorf("case: %v got: %v", c, got)
-		}
-	}
-}
-
-func TestComplex64Set_Size(t *testing.T) {
-	cases := []struct {
-		set  menge.Complex64Set
-		want int
-	}{
-		{menge.NewComplex64Set(), 0},
-		{menge.NewComplex64Set(1, 2), 2},
-	}
-	for _, c := range cases {
-		got := c.set.Size()
-		if got != c.want {
-			t.Errorf("case: %v got: %v", c, got)
-		}
-	}
-}
-
-func TestComplex64Set_IsEmpty(t *testing.T) {
-	cases := []struct {
-		set  menge.Complex64Set
-		want bool
-	}{
-		{menge.NewComplex64Set(), true},
-		{menge.NewComplex64Set(1, 2), false},
-	}
-	for _, c := range cases {
-		got := c.set.IsEmpty()
-		if got != c.want {
-			t.Errorf("case: %v got: %v", c, got)
-		}
-	}
-}
-
-func TestComplex64Set_Clone(t *testing.T) {
-	cases := []struct {
-		set  menge.Complex64Set
-		want menge.Complex64Set
-	}{
-		{menge.NewComplex64Set(), menge.NewComplex64Set()},
-		{menge.NewComplex64Set(1, 2), menge.NewComplex64Set(1, 2)},
-	}
-	for _, c := range cases {
-		got := c.set.Clone()
-		if !got.Equals(c.want) {
-			t.Errorf("case: %v got: %v", c, got)
-		}
-	}
-}
-
-func TestComplex64Set_AsSlice(t *testing.T) {
-	cases := []struct {
-		set  menge.Complex64Set
-		want []complex64
-	}{
-		{menge.NewComplex64Set(), []complex64{}},
-		{menge.NewComplex64Set(1, 2), []complex64{1, 2}},
-	}
-	for _, c := range cases {
-		got := c.set.AsSlice()
-		if len(got) != len(c.want) || !menge.NewComplex64Set(got...).Equals(menge.NewComplex64Set(c.want...)) {
-			t.Errorf("case: %v got: %v", c, got)
-		}
-	}
-}
-
-func TestComplex64Set_String(t *testing.T) {
-	cases := []struct {
-		set  menge.Complex64Set
-		want []string
-	}{
-		{menge.NewComplex64Set(), []string{"{}"}},
-		{menge.NewComplex64Set(1), []string{"{(1+0i)}"}},
-		{menge.NewComplex64Set(1, 2), []string{"{(1+0i) (2+0i)}", "{(2+0i) (1+0i)}"}},
-	}
-	contains := func(ss []string, s string) bool {
-		for _, v := range ss {
-			if v == s {
-				return true
-			}
-		}
-		return false
-	}
-	for _, c := range cases {
-		got := c.set.String()
-		if !contains(c.want, got) {
-			t.Errorf("case: %v got: %v", c, got)
-		}
-	}
-}
-
-func TestComplex64Set_Equals(t *testing.T) {
-	cases := []struct {
-		set  menge.Complex64Set
-		arg  menge.Complex64Set
-		want bool
-	}{
-		{menge.NewComplex64Set(), menge.NewComplex64Set(), true},
-		{menge.NewComplex64Set(1, 2), menge.NewComplex64Set(2, 1), true},
-		{menge.NewComplex64Set(1, 2), menge.NewComplex64Set(1), false},
-		{menge.NewComplex64Set(1), menge.NewComplex64Set(1, 2), false},
-		{menge.NewComplex64Set(1), menge.NewComplex64Set(2), false},
-	}
-	for _, c := range cases {
-		got := c.set.Equals(c.arg)
-		if got != c.want {
-			t.Errorf("case: %v got: %v", c, got)
-		}
-	}
-}
-
-func TestComplex64Set_Union(t *testing.T) {
-	cases := []struct {
-		set  menge.Complex64Set
-		arg  menge.Complex64Set
-		want menge.Complex64Set
-	}{
-		{menge.NewComplex64Set(), menge.NewComplex64Set(), menge.NewComplex64Set()},
-		{menge.NewComplex64Set(1), menge.NewComplex64Set(1), menge.NewComplex64Set(1)},
-		{menge.NewComplex64Set(1), menge.NewComplex64Set(2), menge.NewComplex64Set(1, 2)},
-		{menge.NewComplex64Set(1), menge.NewComplex64Set(1, 2), menge.NewComplex64Set(1, 2)},
-		{menge.NewComplex64Set(1, 2), menge.NewComplex64Set(1), menge.NewComplex64Set(1, 2)},
-	}
-	for _, c := range cases {
-		got := c.set.Union(c.arg)
-		if !got.Equals(c.want) {
-			t.Errorf("case: %v got: %v", c, got)
-		}
-	}
-}
-
-func TestComplex64Set_Intersection(t *testing.T) {
-	cases := []struct {
-		set  menge.Complex64Set
-		arg  menge.Complex64Set
-		want menge.Complex64Set
-	}{
-		{menge.NewComplex64Set(), menge.NewComplex64Set(), menge.NewComplex64Set()},
-		{menge.NewComplex64Set(1), menge.NewComplex64Set(1), menge.NewComplex64Set(1)},
-		{menge.NewComplex64Set(1), menge.NewComplex64Set(2), menge.NewComplex64Set()},
-		{menge.NewComplex64Set(1), menge.NewComplex64Set(1, 2), menge.NewComplex64Set(1)},
-		{menge.NewComplex64Set(1, 2), menge.NewComplex64Set(1), menge.NewComplex64Set(1)},
-	}
-	for _, c := range cases {
-		got := c.set.Intersection(c.arg)
-		if !got.Equals(c.want) {
-			t.Errorf("case: %v got: %v", c, got)
-		}
-	}
-}
-
-func TestComplex64Set_Difference(t *testing.T) {
-	cases := []struct {
-		set  menge.Complex64Set
-		arg  menge.Complex64Set
-		want menge.Complex64Set
-	}{
-		{menge.NewComplex64Set(), menge.NewComplex64Set(), menge.NewComplex64Set()},
-		{menge.NewComplex64Set(1), menge.NewComplex64Set(1), menge.NewComplex64Set()},
-		{menge.NewComplex64Set(1), menge.NewComplex64Set(2), menge.NewComplex64Set(1)},
-		{menge.NewComplex64Set(1), menge.NewComplex64Set(1, 2), menge.NewComplex64Set()},
-		{menge.NewComplex64Set(1, 2), menge.NewComplex64Set(1), menge.NewComplex64Set(2)},
-	}
-	for _, c := range cases {
-		got := c.set.Difference(c.arg)
-		if !got.Equals(c.want) {
-			t.Errorf("case: %v got: %v", c, got)
-		}
-	}
-}
-
-func TestComplex64Set_IsSubsetOf(t *testing.T) {
-	cases := []struct {
-		set  menge.Complex64Set
-		arg  menge.Complex64Set
-		want bool
-	}{
-		{menge.NewComplex64Set(), menge.NewComplex64Set(), true},
-		{menge.NewComplex64Set(1), menge.NewComplex64Set(1), true},
-		{menge.NewComplex64Set(1), menge.NewComplex64Set(1, 2), true},
-		{menge.NewComplex64Set(1, 2), menge.NewComplex64Set(1), false},
-	}
-	for _, c := range cases {
-		got := c.set.IsSubsetOf(c.arg)
-		if got != c.want {
-			t.Errorf("case: %v got: %v", c, got)
-		}
-	}
-}
-
-func TestComplex64Set_IsProperSubsetOf(t *testing.T) {
-	cases := []struct {
-		set  menge.Complex64Set
-		arg  menge.Complex64Set
-		want bool
-	}{
-		{menge.NewComplex64Set(), menge.NewComplex64Set(), false},
-		{menge.NewComplex64Set(1), menge.NewComplex64Set(1), false},
-		{menge.NewComplex64Set(1), menge.NewComplex64Set(1, 2), true},
-		{menge.NewComplex64Set(1, 2), menge.NewComplex64Set(1), false},
-	}
-	for _, c := range cases {
-		got := c.set.IsProperSubsetOf(c.arg)
-		if got != c.want {
-			t.Errorf("case: %v got: %v", c, got)
-		}
-	}
-}
-
-func TestComplex64Set_IsSupersetOf(t *testing.T) {
-	cases := []struct {
-		set  menge.Complex64Set
-		arg  menge.Complex64Set
-		want bool
-	}{
-		{menge.NewComplex64Set(), menge.NewComplex64Set(), true},
-		{menge.NewComplex64Set(1), menge.NewComplex64Set(1), true},
-		{menge.NewComplex64Set(1), menge.NewComplex64Set(1, 2), false},
-		{menge.NewComplex64Set(1, 2), menge.NewComplex64Set(1), true},
-	}
-	for _, c := range cases {
-		got := c.set.IsSupersetOf(c.arg)
-		if got != c.want {
-			t.Errorf("case: %v got: %v", c, got)
-		}
-	}
-}
-
-func TestComplex64Set_IsProperSupersetOf(t *testing.T) {
-	cases := []struct {
-		set  menge.Complex64Set
-		arg  menge.Complex64Set
-		want bool
-	}{
-		{menge.NewComplex64Set(), menge.NewComplex64Set(), false},
-		{menge.NewComplex64Set(1), menge.NewComplex64Set(1), false},
-		{menge.NewComplex64Set(1), menge.NewComplex64Set(1, 2), false},
-		{menge.NewComplex64Set(1, 2), menge.NewComplex64Set(1), true},
-	}
-	for _, c := range cases {
-		got := c.set.IsProperSupersetOf(c.arg)
-		if got != c.want {
-			t.Errorf("case: %v got: %v", c, got)
-		}
-	}
-}
-
-func TestComplex64Set_IsDisjointFrom(t *testing.T) {
-	cases := []struct {
-		set  menge.Complex64Set
-		arg  menge.Complex64Set
-		want bool
-	}{
-		{menge.NewComplex64Set(), menge.NewComplex64Set(), true},
-		{menge.NewComplex64Set(1), menge.NewComplex64Set(1), false},
-		{menge.NewComplex64Set(1), menge.NewComplex64Set(2, 3), true},
-		{menge.NewComplex64Set(1, 2), menge.NewComplex64Set(3), true},
-		{menge.NewComplex64Set(1), menge.NewComplex64Set(1, 2), false},
-		{menge.NewComplex64Set(1, 2), menge.NewComplex64Set(1), false},
-	}
-	for _, c := range cases {
-		got := c.set.IsDisjointFrom(c.arg)
-		if got != c.want {
-			t.Errorf("case: %v got: %v", c, got)
-		}
-	}
-}
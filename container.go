@@ -0,0 +1,382 @@
+package menge
+
+import (
+	"math/bits"
+	"sort"
+)
+
+// The containers of a roaring bitmap hold the low 16 bits of the elements
+// that share the same high bits, in one of three representations:
+//   - An array container is a sorted slice of at most arrayMax elements.
+//   - A bitmap container is a bitmap of 1024 words, with more than arrayMax
+//     elements.
+//   - A run container is a sorted slice of intervals of consecutive elements.
+//     Run containers are created only by optimize and by decoding, and are
+//     converted to one of the other representations when they are modified.
+const (
+	arrayContainer = iota
+	bitmapContainer
+	runContainer
+)
+
+// arrayMax is the maximum size of an array container.
+const arrayMax = 4096
+
+// bitmapWords is the number of words of a bitmap container.
+const bitmapWords = 1 << 16 / 64
+
+// interval is an interval of consecutive elements of a run container.
+type interval struct {
+	start, last uint16
+}
+
+// container is a container of a roaring bitmap. A container is never empty.
+type container struct {
+	kind  int
+	card  int
+	array []uint16
+	words []uint64
+	runs  []interval
+}
+
+// has indicates whether the container has x.
+func (c *container) has(x uint16) bool {
+	switch c.kind {
+	case arrayContainer:
+		i := sort.Search(len(c.array), func(i int) bool { return c.array[i] >= x })
+		return i < len(c.array) && c.array[i] == x
+	case bitmapContainer:
+		return c.words[x/64]&(1<<(x%64)) != 0
+	}
+	i := sort.Search(len(c.runs), func(i int) bool { return c.runs[i].last >= x })
+	return i < len(c.runs) && c.runs[i].start <= x
+}
+
+// add adds x to the container and reports whether it was added.
+func (c *container) add(x uint16) bool {
+	switch c.kind {
+	case arrayContainer:
+		i := sort.Search(len(c.array), func(i int) bool { return c.array[i] >= x })
+		if i < len(c.array) && c.array[i] == x {
+			return false
+		}
+		c.array = append(c.array, 0)
+		copy(c.array[i+1:], c.array[i:])
+		c.array[i] = x
+		c.card++
+		if c.card > arrayMax {
+			c.setWords(c.bitmap(), c.card)
+		}
+		return true
+	case bitmapContainer:
+		if c.words[x/64]&(1<<(x%64)) != 0 {
+			return false
+		}
+		c.words[x/64] |= 1 << (x % 64)
+		c.card++
+		return true
+	}
+	if c.has(x) {
+		return false
+	}
+	c.setWords(c.bitmap(), c.card)
+	return c.add(x)
+}
+
+// remove removes x from the container and reports whether it was removed.
+func (c *container) remove(x uint16) bool {
+	switch c.kind {
+	case arrayContainer:
+		i := sort.Search(len(c.array), func(i int) bool { return c.array[i] >= x })
+		if i == len(c.array) || c.array[i] != x {
+			return false
+		}
+		c.array = append(c.array[:i], c.array[i+1:]...)
+		c.card--
+		return true
+	case bitmapContainer:
+		if c.words[x/64]&(1<<(x%64)) == 0 {
+			return false
+		}
+		c.words[x/64] &^= 1 << (x % 64)
+		c.setWords(c.words, c.card-1)
+		return true
+	}
+	if !c.has(x) {
+		return false
+	}
+	c.setWords(c.bitmap(), c.card)
+	return c.remove(x)
+}
+
+// setWords sets the elements of the container to those of the bitmap w of
+// size card, using an array container if card is at most arrayMax.
+func (c *container) setWords(w []uint64, card int) {
+	c.card, c.runs = card, nil
+	if card > arrayMax {
+		c.kind, c.array, c.words = bitmapContainer, nil, w
+		return
+	}
+	a := make([]uint16, 0, card)
+	for i, w := range w {
+		for w != 0 {
+			a = append(a, uint16(i*64+bits.TrailingZeros64(w)))
+			w &= w - 1
+		}
+	}
+	c.kind, c.array, c.words = arrayContainer, a, nil
+}
+
+// bitmap returns a new bitmap of the elements of the container.
+func (c *container) bitmap() []uint64 {
+	w := make([]uint64, bitmapWords)
+	switch c.kind {
+	case arrayContainer:
+		for _, x := range c.array {
+			w[x/64] |= 1 << (x % 64)
+		}
+	case bitmapContainer:
+		copy(w, c.words)
+	case runContainer:
+		for _, r := range c.runs {
+			for x := int(r.start); x <= int(r.last); x++ {
+				w[x/64] |= 1 << (x % 64)
+			}
+		}
+	}
+	return w
+}
+
+// ascend calls f for each element of the container in ascending order,
+// until f returns false, and reports whether f never returned false.
+func (c *container) ascend(f func(uint16) bool) bool {
+	switch c.kind {
+	case arrayContainer:
+		for _, x := range c.array {
+			if !f(x) {
+				return false
+			}
+		}
+	case bitmapContainer:
+		for i, w := range c.words {
+			for w != 0 {
+				if !f(uint16(i*64 + bits.TrailingZeros64(w))) {
+					return false
+				}
+				w &= w - 1
+			}
+		}
+	case runContainer:
+		for _, r := range c.runs {
+			for x := int(r.start); x <= int(r.last); x++ {
+				if !f(uint16(x)) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// descend calls f for each element of the container in descending order,
+// until f returns false, and reports whether f never returned false.
+func (c *container) descend(f func(uint16) bool) bool {
+	switch c.kind {
+	case arrayContainer:
+		for i := len(c.array) - 1; i >= 0; i-- {
+			if !f(c.array[i]) {
+				return false
+			}
+		}
+	case bitmapContainer:
+		for i := len(c.words) - 1; i >= 0; i-- {
+			for w := c.words[i]; w != 0; {
+				j := 63 - bits.LeadingZeros64(w)
+				if !f(uint16(i*64 + j)) {
+					return false
+				}
+				w &^= 1 << j
+			}
+		}
+	case runContainer:
+		for i := len(c.runs) - 1; i >= 0; i-- {
+			for x := int(c.runs[i].last); x >= int(c.runs[i].start); x-- {
+				if !f(uint16(x)) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// clone returns a deep copy of the container.
+func (c *container) clone() *container {
+	r := &container{kind: c.kind, card: c.card}
+	switch c.kind {
+	case arrayContainer:
+		r.array = append([]uint16(nil), c.array...)
+	case bitmapContainer:
+		r.words = append([]uint64(nil), c.words...)
+	case runContainer:
+		r.runs = append([]interval(nil), c.runs...)
+	}
+	return r
+}
+
+// optimize converts the container to a run container if that is smaller,
+// and a run container to one of the other representations if that is not.
+// It reports whether the container is a run container.
+func (c *container) optimize() bool {
+	n := 0
+	prev := -2
+	c.ascend(func(x uint16) bool {
+		if int(x) != prev+1 {
+			n++
+		}
+		prev = int(x)
+		return true
+	})
+	size := 8192
+	if c.card <= arrayMax {
+		size = 2 * c.card
+	}
+	if 2+4*n >= size {
+		if c.kind == runContainer {
+			c.setWords(c.bitmap(), c.card)
+		}
+		return false
+	}
+	if c.kind == runContainer {
+		return true
+	}
+	runs := make([]interval, 0, n)
+	c.ascend(func(x uint16) bool {
+		if k := len(runs) - 1; k >= 0 && int(runs[k].last)+1 == int(x) {
+			runs[k].last = x
+		} else {
+			runs = append(runs, interval{x, x})
+		}
+		return true
+	})
+	c.kind, c.array, c.words, c.runs = runContainer, nil, nil, runs
+	return true
+}
+
+// setOp is a binary set operation.
+type setOp int
+
+const (
+	opUnion setOp = iota
+	opIntersection
+	opDifference
+	opSymmetricDifference
+)
+
+// keep indicates whether op keeps an element given whether it is in its
+// operands.
+func (op setOp) keep(inA, inB bool) bool {
+	switch op {
+	case opUnion:
+		return inA || inB
+	case opIntersection:
+		return inA && inB
+	case opDifference:
+		return inA && !inB
+	}
+	return inA != inB
+}
+
+// combine returns the result of op on a and b, or nil if it is empty.
+func combine(a, b *container, op setOp) *container {
+	switch {
+	case a.kind == arrayContainer && b.kind == arrayContainer:
+		r := make([]uint16, 0, a.card+b.card)
+		mergeSorted(a.array, b.array, func(x uint16, inA, inB bool) {
+			if op.keep(inA, inB) {
+				r = append(r, x)
+			}
+		})
+		return fromArray(r)
+	case a.kind == arrayContainer && (op == opIntersection || op == opDifference):
+		return filter(a, func(x uint16) bool { return b.has(x) == (op == opIntersection) })
+	case b.kind == arrayContainer && op == opIntersection:
+		return filter(b, a.has)
+	}
+	wa, wb := a.words, b.words
+	if a.kind != bitmapContainer {
+		wa = a.bitmap()
+	}
+	if b.kind != bitmapContainer {
+		wb = b.bitmap()
+	}
+	w := make([]uint64, bitmapWords)
+	card := 0
+	for i := range w {
+		switch op {
+		case opUnion:
+			w[i] = wa[i] | wb[i]
+		case opIntersection:
+			w[i] = wa[i] & wb[i]
+		case opDifference:
+			w[i] = wa[i] &^ wb[i]
+		default:
+			w[i] = wa[i] ^ wb[i]
+		}
+		card += bits.OnesCount64(w[i])
+	}
+	if card == 0 {
+		return nil
+	}
+	r := &container{}
+	r.setWords(w, card)
+	return r
+}
+
+// fromArray returns a container of the strictly ascending elements a,
+// or nil if a is empty.
+func fromArray(a []uint16) *container {
+	switch {
+	case len(a) == 0:
+		return nil
+	case len(a) <= arrayMax:
+		return &container{kind: arrayContainer, card: len(a), array: a}
+	}
+	c := &container{kind: arrayContainer, card: len(a), array: a}
+	c.setWords(c.bitmap(), len(a))
+	return c
+}
+
+// filter returns a container of the elements of c for which keep returns
+// true, or nil if there are none.
+func filter(c *container, keep func(uint16) bool) *container {
+	var a []uint16
+	c.ascend(func(x uint16) bool {
+		if keep(x) {
+			a = append(a, x)
+		}
+		return true
+	})
+	return fromArray(a)
+}
+
+// andCard returns the size of the intersection of a and b.
+func andCard(a, b *container) int {
+	n := 0
+	if a.kind == bitmapContainer && b.kind == bitmapContainer {
+		for i := range a.words {
+			n += bits.OnesCount64(a.words[i] & b.words[i])
+		}
+		return n
+	}
+	if a.card > b.card {
+		a, b = b, a
+	}
+	a.ascend(func(x uint16) bool {
+		if b.has(x) {
+			n++
+		}
+		return true
+	})
+	return n
+}
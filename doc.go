@@ -1,2 +1,2 @@
-// Package menge implements type-safe sets of all basic types.
+// Package menge implements a generic set type and type-safe sets of all basic types.
 package menge
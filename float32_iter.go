@@ -0,0 +1,30 @@
+// Code generated by mengegen. DO NOT EDIT.
+
+//go:build go1.23
+
+package menge
+
+import "iter"
+
+// All returns an iterator over the elements of the set,
+// with no specific order of the elements.
+func (s Float32Set) All() iter.Seq[float32] {
+	return Set[float32](s).All()
+}
+
+// Sorted returns an iterator over the elements of the set,
+// in their natural order, as used by String.
+// The elements are sorted when the iteration starts.
+func (s Float32Set) Sorted() iter.Seq[float32] {
+	return Set[float32](s).Sorted()
+}
+
+// CollectFloat32Set returns a new Float32Set containing the elements of seq.
+// NaN values are ignored, as they are by Add.
+func CollectFloat32Set(seq iter.Seq[float32]) Float32Set {
+	s := NewFloat32Set()
+	for e := range seq {
+		s.Add(e)
+	}
+	return s
+}
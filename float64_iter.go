@@ -0,0 +1,30 @@
+// Code generated by mengegen. DO NOT EDIT.
+
+//go:build go1.23
+
+package menge
+
+import "iter"
+
+// All returns an iterator over the elements of the set,
+// with no specific order of the elements.
+func (s Float64Set) All() iter.Seq[float64] {
+	return Set[float64](s).All()
+}
+
+// Sorted returns an iterator over the elements of the set,
+// in their natural order, as used by String.
+// The elements are sorted when the iteration starts.
+func (s Float64Set) Sorted() iter.Seq[float64] {
+	return Set[float64](s).Sorted()
+}
+
+// CollectFloat64Set returns a new Float64Set containing the elements of seq.
+// NaN values are ignored, as they are by Add.
+func CollectFloat64Set(seq iter.Seq[float64]) Float64Set {
+	s := NewFloat64Set()
+	for e := range seq {
+		s.Add(e)
+	}
+	return s
+}
@@ -0,0 +1,58 @@
+// Package fold provides the folds of menge.FoldedStringSet that need the
+// Unicode tables of golang.org/x/text: full Unicode case folding and Unicode
+// normalization. They are kept apart from package menge, so that it has no
+// dependencies.
+package fold
+
+import (
+	"github.com/soroushj/menge"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Case returns the full Unicode case folding of s, so that, unlike with
+// menge.FoldCase, a letter may be folded to multiple letters, e.g., "ß" and
+// "STRASSE" to "strasse".
+func Case(s string) string {
+	// A Caser is stateful, so a new one is used for each call.
+	return cases.Fold().String(s)
+}
+
+// NFC returns the Unicode Normalization Form C of s, in which the canonically
+// equivalent strings are the same, e.g., a composed "Å" and "A" followed by a
+// combining ring.
+func NFC(s string) string {
+	return norm.NFC.String(s)
+}
+
+// NFKC returns the Unicode Normalization Form KC of s, in which the
+// compatibility equivalent strings are the same, e.g., "ﬁ" and "fi", and
+// "²" and "2", besides the canonically equivalent ones.
+func NFKC(s string) string {
+	return norm.NFKC.String(s)
+}
+
+// Caseless returns the key of s for caseless matching: its NFC form after
+// full case folding, so that strings that differ only in case or in the
+// encoding of their accents have the same key, e.g., "Ådmin" and "ÅDMIN".
+func Caseless(s string) string {
+	return norm.NFC.String(Case(norm.NFD.String(s)))
+}
+
+// NewNFCStringSet returns a new menge.FoldedStringSet that folds its elements
+// with NFC, containing zero or more elements.
+func NewNFCStringSet(elems ...string) *menge.FoldedStringSet {
+	return menge.NewFoldedStringSet(NFC, elems...)
+}
+
+// NewNFKCStringSet returns a new menge.FoldedStringSet that folds its
+// elements with NFKC, containing zero or more elements.
+func NewNFKCStringSet(elems ...string) *menge.FoldedStringSet {
+	return menge.NewFoldedStringSet(NFKC, elems...)
+}
+
+// NewCaselessStringSet returns a new menge.FoldedStringSet that folds its
+// elements with Caseless, containing zero or more elements.
+func NewCaselessStringSet(elems ...string) *menge.FoldedStringSet {
+	return menge.NewFoldedStringSet(Caseless, elems...)
+}
@@ -0,0 +1,57 @@
+package fold_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/soroushj/menge"
+	"github.com/soroushj/menge/fold"
+)
+
+func TestFolds(t *testing.T) {
+	cases := []struct {
+		name string
+		fold menge.FoldFunc
+		a, b string
+		same bool
+	}{
+		{"Case", fold.Case, "stra\u00dfe", "STRASSE", true},
+		{"Case", fold.Case, "Admin", "ADMIN", true},
+		{"Case", fold.Case, "\u00c5dmin", "A\u030admin", false},
+		{"NFC", fold.NFC, "\u00c5dmin", "A\u030admin", true},
+		{"NFC", fold.NFC, "\u00c5dmin", "\u00e5dmin", false},
+		{"NFC", fold.NFC, "\ufb01le", "file", false},
+		{"NFKC", fold.NFKC, "\ufb01le", "file", true},
+		{"NFKC", fold.NFKC, "x\u00b2", "x2", true},
+		{"NFKC", fold.NFKC, "\u00c5dmin", "A\u030admin", true},
+		{"Caseless", fold.Caseless, "\u00c5dmin", "A\u030aDMIN", true},
+		{"Caseless", fold.Caseless, "Stra\u00dfe", "STRASSE", true},
+		{"Caseless", fold.Caseless, "Admin", "Admins", false},
+		{"FoldCase", menge.FoldCase, "stra\u00dfe", "STRASSE", false},
+	}
+	for _, c := range cases {
+		if got := c.fold(c.a) == c.fold(c.b); got != c.same {
+			t.Errorf("%s(%q) == %s(%q) got: %v", c.name, c.a, c.name, c.b, got)
+		}
+	}
+}
+
+func TestNewStringSets(t *testing.T) {
+	users := fold.NewCaselessStringSet("\u00c5dmin", "A\u030aDMIN", "Stra\u00dfe", "guest")
+	if users.Size() != 3 || !users.Has("STRASSE") || !users.Has("\u00e5dmin") || users.String() != "{Stra\u00dfe guest \u00c5dmin}" {
+		t.Errorf("NewCaselessStringSet got: %v", users)
+	}
+	if e, ok := users.Get("strasse"); !ok || e != "Stra\u00dfe" {
+		t.Errorf("Get got: %q %v", e, ok)
+	}
+	if s := fold.NewNFCStringSet("\u00c5", "A\u030a", "a\u030a"); s.Size() != 2 || !s.Has("\u00e5") {
+		t.Errorf("NewNFCStringSet got: %v", s)
+	}
+	if s := fold.NewNFKCStringSet("\ufb01le", "file", "File"); s.Size() != 2 || s.String() != "{File \ufb01le}" {
+		t.Errorf("NewNFKCStringSet got: %v", s)
+	}
+	tags := menge.NewFoldedStringSet(menge.ChainFolds(strings.TrimSpace, fold.Caseless), " Stra\u00dfe ", "STRASSE")
+	if tags.Size() != 1 {
+		t.Errorf("chained folds got: %v", tags)
+	}
+}
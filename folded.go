@@ -0,0 +1,517 @@
+package menge
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// FoldFunc maps a string to the key by which a FoldedStringSet compares it,
+// so that strings with the same key are considered equal.
+// Besides FoldCase and ChainFolds, any func(string) string can be used,
+// such as strings.TrimSpace and strings.ToLower, or the folds of package
+// github.com/soroushj/menge/fold, which uses golang.org/x/text for full
+// Unicode case folding and NFC and NFKC normalization.
+type FoldFunc func(s string) string
+
+// FoldCase returns the simple Unicode case folding of s, so that two strings
+// have the same folding if and only if strings.EqualFold reports them equal,
+// e.g., "Admin", "ADMIN" and "admin". Letters are generally folded to lower
+// case. It does not fold a letter to multiple letters, e.g., "ß" to "ss".
+func FoldCase(s string) string {
+	i := 0
+	for i < len(s) && s[i] < utf8.RuneSelf && (s[i] < 'A' || s[i] > 'Z') {
+		i++
+	}
+	if i == len(s) {
+		return s
+	}
+	b := &strings.Builder{}
+	b.Grow(len(s))
+	b.WriteString(s[:i])
+	for _, r := range s[i:] {
+		b.WriteRune(foldRune(r))
+	}
+	return b.String()
+}
+
+// foldRune returns the representative of the case folding orbit of r, which
+// is its least lower case rune, if any, or else its least rune.
+func foldRune(r rune) rune {
+	if r < utf8.RuneSelf {
+		if 'A' <= r && r <= 'Z' {
+			r += 'a' - 'A'
+		}
+		return r
+	}
+	c := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if l, m := unicode.IsLower(f), unicode.IsLower(c); l && !m || l == m && f < c {
+			c = f
+		}
+	}
+	return c
+}
+
+// ChainFolds returns a FoldFunc that applies zero or more folds in order,
+// e.g., ChainFolds(strings.TrimSpace, FoldCase).
+func ChainFolds(folds ...FoldFunc) FoldFunc {
+	return func(s string) string {
+		for _, f := range folds {
+			s = f(s)
+		}
+		return s
+	}
+}
+
+// FoldedStringSet represents a set of string elements that are compared by
+// their keys, given by a FoldFunc, e.g., a case-insensitive set with
+// FoldCase. It keeps the first-seen spelling of each element, which is
+// returned by its methods and used by String; adding an element whose key is
+// in the set does not replace it.
+// The binary set operations compare the elements of t by their keys in t,
+// so s and t are expected to have the same FoldFunc; where both have an
+// element, the spelling in s is kept.
+// The zero value is an empty set ready to use, with no folding.
+type FoldedStringSet struct {
+	fold FoldFunc
+	m    map[string]string
+}
+
+// key returns the key of e.
+func (s *FoldedStringSet) key(e string) string {
+	if s.fold == nil {
+		return e
+	}
+	return s.fold(e)
+}
+
+// empty returns an empty set with the same FoldFunc as s.
+func (s *FoldedStringSet) empty(size int) *FoldedStringSet {
+	return &FoldedStringSet{s.fold, make(map[string]string, size)}
+}
+
+// Fold returns the FoldFunc of the set.
+func (s *FoldedStringSet) Fold() FoldFunc {
+	return s.fold
+}
+
+// Add adds zero or more elements to the set.
+// An element whose key is in the set is not added.
+func (s *FoldedStringSet) Add(elems ...string) {
+	if s.m == nil {
+		s.m = make(map[string]string, len(elems))
+	}
+	for _, e := range elems {
+		k := s.key(e)
+		if _, ok := s.m[k]; !ok {
+			s.m[k] = e
+		}
+	}
+}
+
+// Remove removes the elements with the keys of zero or more elements from
+// the set.
+func (s *FoldedStringSet) Remove(elems ...string) {
+	for _, e := range elems {
+		delete(s.m, s.key(e))
+	}
+}
+
+// Empty empties the set.
+func (s *FoldedStringSet) Empty() {
+	for k := range s.m {
+		delete(s.m, k)
+	}
+}
+
+// Has indicates whether the set has an element with the key of elem.
+func (s *FoldedStringSet) Has(elem string) bool {
+	_, ok := s.m[s.key(elem)]
+	return ok
+}
+
+// Get returns the spelling of the element of the set with the key of elem,
+// and whether there is one.
+func (s *FoldedStringSet) Get(elem string) (string, bool) {
+	e, ok := s.m[s.key(elem)]
+	return e, ok
+}
+
+// Size returns the size of the set.
+func (s *FoldedStringSet) Size() int {
+	return len(s.m)
+}
+
+// IsEmpty indicates whether the set is empty.
+func (s *FoldedStringSet) IsEmpty() bool {
+	return len(s.m) == 0
+}
+
+// Clone returns a clone of the set.
+func (s *FoldedStringSet) Clone() *FoldedStringSet {
+	r := s.empty(len(s.m))
+	for k, e := range s.m {
+		r.m[k] = e
+	}
+	return r
+}
+
+// AsSlice returns an equivalent slice with no specific order of the elements.
+func (s *FoldedStringSet) AsSlice() []string {
+	return s.AppendTo(make([]string, 0, len(s.m)))
+}
+
+// AsSortedSlice returns an equivalent slice with the elements in ascending
+// order of their spellings.
+func (s *FoldedStringSet) AsSortedSlice() []string {
+	a := s.AsSlice()
+	sort.Strings(a)
+	return a
+}
+
+// AsSortedSliceDesc returns an equivalent slice with the elements in
+// descending order of their spellings.
+func (s *FoldedStringSet) AsSortedSliceDesc() []string {
+	a := s.AsSlice()
+	sort.Sort(sort.Reverse(sort.StringSlice(a)))
+	return a
+}
+
+// AppendTo appends the elements of the set to dst, with no specific order,
+// and returns the extended slice. It allocates only if dst does not have
+// enough capacity.
+func (s *FoldedStringSet) AppendTo(dst []string) []string {
+	for _, e := range s.m {
+		dst = append(dst, e)
+	}
+	return dst
+}
+
+// ToStringSet returns a StringSet of the elements of the set,
+// with their spellings in the set.
+func (s *FoldedStringSet) ToStringSet() StringSet {
+	r := make(StringSet, len(s.m))
+	for _, e := range s.m {
+		r[e] = struct{}{}
+	}
+	return r
+}
+
+// String returns a string representation of the set,
+// with its elements in ascending order of their spellings.
+func (s *FoldedStringSet) String() string {
+	b := &strings.Builder{}
+	writeElems(b, s.AsSortedSlice(), "%v", " ")
+	return b.String()
+}
+
+// Format implements the fmt.Formatter interface.
+// See Set.Format for the supported verbs. The %#v verb formats the set as
+// a call to NewFoldedStringSet, with fold standing for its FoldFunc.
+func (s *FoldedStringSet) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		io.WriteString(f, "menge.NewFoldedStringSet(fold")
+		for _, e := range s.AsSortedSlice() {
+			fmt.Fprintf(f, ", %#v", e)
+		}
+		io.WriteString(f, ")")
+		return
+	}
+	formatElems(f, verb, s.AsSortedSlice(), "")
+}
+
+// Equals indicates whether s and t have elements with the same keys.
+func (s *FoldedStringSet) Equals(t *FoldedStringSet) bool {
+	return len(s.m) == len(t.m) && s.IsSubsetOf(t)
+}
+
+// Union returns the union of s and t.
+func (s *FoldedStringSet) Union(t *FoldedStringSet) *FoldedStringSet {
+	r := s.Clone()
+	r.UnionWith(t)
+	return r
+}
+
+// Intersection returns the intersection of s and t.
+func (s *FoldedStringSet) Intersection(t *FoldedStringSet) *FoldedStringSet {
+	r := s.empty(0)
+	for k, e := range s.m {
+		if _, ok := t.m[k]; ok {
+			r.m[k] = e
+		}
+	}
+	return r
+}
+
+// Difference returns the difference of s and t, i.e., s - t.
+func (s *FoldedStringSet) Difference(t *FoldedStringSet) *FoldedStringSet {
+	r := s.empty(0)
+	for k, e := range s.m {
+		if _, ok := t.m[k]; !ok {
+			r.m[k] = e
+		}
+	}
+	return r
+}
+
+// SymmetricDifference returns the symmetric difference of s and t,
+// i.e., (s - t) ⋃ (t - s).
+func (s *FoldedStringSet) SymmetricDifference(t *FoldedStringSet) *FoldedStringSet {
+	r := s.Difference(t)
+	for k, e := range t.m {
+		if _, ok := s.m[k]; !ok {
+			r.m[k] = e
+		}
+	}
+	return r
+}
+
+// UnionWith adds the elements of t to s, i.e., s = s ⋃ t.
+func (s *FoldedStringSet) UnionWith(t *FoldedStringSet) {
+	if s.m == nil {
+		s.m = make(map[string]string, len(t.m))
+	}
+	for k, e := range t.m {
+		if _, ok := s.m[k]; !ok {
+			s.m[k] = e
+		}
+	}
+}
+
+// IntersectWith removes the elements of s that are not in t, i.e., s = s ⋂ t.
+func (s *FoldedStringSet) IntersectWith(t *FoldedStringSet) {
+	for k := range s.m {
+		if _, ok := t.m[k]; !ok {
+			delete(s.m, k)
+		}
+	}
+}
+
+// DifferenceWith removes the elements of t from s, i.e., s = s - t.
+func (s *FoldedStringSet) DifferenceWith(t *FoldedStringSet) {
+	if s == t {
+		s.Empty()
+		return
+	}
+	for k := range t.m {
+		delete(s.m, k)
+	}
+}
+
+// SymmetricDifferenceWith replaces s with the symmetric difference of s and t,
+// i.e., s = (s - t) ⋃ (t - s).
+func (s *FoldedStringSet) SymmetricDifferenceWith(t *FoldedStringSet) {
+	*s = *s.SymmetricDifference(t)
+}
+
+// IsSubsetOf indicates whether s is a subset of t.
+func (s *FoldedStringSet) IsSubsetOf(t *FoldedStringSet) bool {
+	if len(s.m) > len(t.m) {
+		return false
+	}
+	for k := range s.m {
+		if _, ok := t.m[k]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// IsProperSubsetOf indicates whether s is a proper subset of t.
+func (s *FoldedStringSet) IsProperSubsetOf(t *FoldedStringSet) bool {
+	return len(s.m) < len(t.m) && s.IsSubsetOf(t)
+}
+
+// IsSupersetOf indicates whether s is a superset of t.
+func (s *FoldedStringSet) IsSupersetOf(t *FoldedStringSet) bool {
+	return t.IsSubsetOf(s)
+}
+
+// IsProperSupersetOf indicates whether s is a proper superset of t.
+func (s *FoldedStringSet) IsProperSupersetOf(t *FoldedStringSet) bool {
+	return t.IsProperSubsetOf(s)
+}
+
+// IsDisjointFrom indicates whether s and t are disjoint.
+func (s *FoldedStringSet) IsDisjointFrom(t *FoldedStringSet) bool {
+	return s.IntersectionSize(t) == 0
+}
+
+// IntersectionSize returns the size of the intersection of s and t,
+// without computing the intersection.
+func (s *FoldedStringSet) IntersectionSize(t *FoldedStringSet) int {
+	if len(s.m) > len(t.m) {
+		s, t = t, s
+	}
+	n := 0
+	for k := range s.m {
+		if _, ok := t.m[k]; ok {
+			n++
+		}
+	}
+	return n
+}
+
+// UnionSize returns the size of the union of s and t,
+// without computing the union.
+func (s *FoldedStringSet) UnionSize(t *FoldedStringSet) int {
+	return len(s.m) + len(t.m) - s.IntersectionSize(t)
+}
+
+// DifferenceSize returns the size of the difference of s and t, i.e., s - t,
+// without computing the difference.
+func (s *FoldedStringSet) DifferenceSize(t *FoldedStringSet) int {
+	return len(s.m) - s.IntersectionSize(t)
+}
+
+// Jaccard returns the Jaccard index of s and t, i.e., |s ⋂ t| / |s ⋃ t|.
+// The Jaccard index of two empty sets is 1.
+func (s *FoldedStringSet) Jaccard(t *FoldedStringSet) float64 {
+	return jaccard(s.IntersectionSize(t), len(s.m), len(t.m))
+}
+
+// SorensenDice returns the Sørensen–Dice coefficient of s and t,
+// i.e., 2|s ⋂ t| / (|s| + |t|).
+// The Sørensen–Dice coefficient of two empty sets is 1.
+func (s *FoldedStringSet) SorensenDice(t *FoldedStringSet) float64 {
+	return sorensenDice(s.IntersectionSize(t), len(s.m), len(t.m))
+}
+
+// OverlapCoefficient returns the overlap coefficient of s and t,
+// i.e., |s ⋂ t| / min(|s|, |t|).
+// The overlap coefficient of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s *FoldedStringSet) OverlapCoefficient(t *FoldedStringSet) float64 {
+	return overlapCoefficient(s.IntersectionSize(t), len(s.m), len(t.m))
+}
+
+// CosineSimilarity returns the cosine similarity of s and t,
+// i.e., |s ⋂ t| / √(|s||t|).
+// The cosine similarity of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s *FoldedStringSet) CosineSimilarity(t *FoldedStringSet) float64 {
+	return cosineSimilarity(s.IntersectionSize(t), len(s.m), len(t.m))
+}
+
+// Filter returns a new set containing the elements of s for which pred
+// returns true.
+func (s *FoldedStringSet) Filter(pred func(elem string) bool) *FoldedStringSet {
+	r := s.empty(0)
+	for k, e := range s.m {
+		if pred(e) {
+			r.m[k] = e
+		}
+	}
+	return r
+}
+
+// Retain removes the elements of s for which pred returns false.
+func (s *FoldedStringSet) Retain(pred func(elem string) bool) {
+	for k, e := range s.m {
+		if !pred(e) {
+			delete(s.m, k)
+		}
+	}
+}
+
+// RemoveIf removes the elements of s for which pred returns true.
+func (s *FoldedStringSet) RemoveIf(pred func(elem string) bool) {
+	for k, e := range s.m {
+		if pred(e) {
+			delete(s.m, k)
+		}
+	}
+}
+
+// Partition returns two new sets containing the elements of s for which pred
+// returns true and false, respectively.
+func (s *FoldedStringSet) Partition(pred func(elem string) bool) (in, out *FoldedStringSet) {
+	in, out = s.empty(0), s.empty(0)
+	for k, e := range s.m {
+		if pred(e) {
+			in.m[k] = e
+		} else {
+			out.m[k] = e
+		}
+	}
+	return in, out
+}
+
+// Any indicates whether pred returns true for any element of s.
+// It returns false for an empty set.
+func (s *FoldedStringSet) Any(pred func(elem string) bool) bool {
+	for _, e := range s.m {
+		if pred(e) {
+			return true
+		}
+	}
+	return false
+}
+
+// Every indicates whether pred returns true for all elements of s.
+// It returns true for an empty set.
+func (s *FoldedStringSet) Every(pred func(elem string) bool) bool {
+	for _, e := range s.m {
+		if !pred(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// None indicates whether pred returns false for all elements of s.
+// It returns true for an empty set.
+func (s *FoldedStringSet) None(pred func(elem string) bool) bool {
+	return !s.Any(pred)
+}
+
+// Count returns the number of elements of s for which pred returns true.
+func (s *FoldedStringSet) Count(pred func(elem string) bool) int {
+	n := 0
+	for _, e := range s.m {
+		if pred(e) {
+			n++
+		}
+	}
+	return n
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The set is encoded as a JSON array of the spellings of its elements,
+// in ascending order.
+func (s *FoldedStringSet) MarshalJSON() ([]byte, error) {
+	return marshalElems(s.AsSortedSlice())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It replaces the elements of the set with the decoded ones, in their order
+// in the array, and keeps the FoldFunc of the set.
+func (s *FoldedStringSet) UnmarshalJSON(data []byte) error {
+	var a []string
+	if err := json.Unmarshal(data, &a); err != nil || a == nil {
+		return err
+	}
+	s.m = nil
+	s.Add(a...)
+	return nil
+}
+
+// NewFoldedStringSet returns a new FoldedStringSet with a FoldFunc,
+// containing zero or more elements. Of the elements with the same key,
+// the first is kept. A nil fold does no folding.
+func NewFoldedStringSet(fold FoldFunc, elems ...string) *FoldedStringSet {
+	s := &FoldedStringSet{fold: fold}
+	s.Add(elems...)
+	return s
+}
+
+// NewCaseInsensitiveStringSet returns a new FoldedStringSet that folds its
+// elements with FoldCase, containing zero or more elements.
+func NewCaseInsensitiveStringSet(elems ...string) *FoldedStringSet {
+	return NewFoldedStringSet(FoldCase, elems...)
+}
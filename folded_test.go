@@ -0,0 +1,109 @@
+package menge_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/soroushj/menge"
+)
+
+func TestFoldCase(t *testing.T) {
+	words := []string{"", "admin", "Admin", "ADMIN", "k", "K", "K", "s", "S", "ſ", "σ", "ς", "Σ", "ß", "ẞ", "SS", "İ", "i", "I", "Ǆ", "ǅ", "ǆ", "a1-B", "A1-b", "日本", "\xff", "\xfe"}
+	for _, a := range words {
+		for _, b := range words {
+			if got, want := menge.FoldCase(a) == menge.FoldCase(b), strings.EqualFold(a, b); got != want {
+				t.Errorf("%q and %q got: %q %q", a, b, menge.FoldCase(a), menge.FoldCase(b))
+			}
+		}
+	}
+	if got := menge.FoldCase("Hello, WORLD"); got != "hello, world" {
+		t.Errorf("got: %q", got)
+	}
+	if got := menge.ChainFolds(strings.TrimSpace, menge.FoldCase)("  Admin\t"); got != "admin" {
+		t.Errorf("ChainFolds got: %q", got)
+	}
+}
+
+func TestFoldedStringSet(t *testing.T) {
+	s := menge.NewCaseInsensitiveStringSet("Admin", "admin", "Guest", "ADMIN")
+	if s.Size() != 2 || !s.Has("aDmIn") || s.Has("root") || s.String() != "{Admin Guest}" {
+		t.Errorf("got: %v", s)
+	}
+	if e, ok := s.Get("GUEST"); !ok || e != "Guest" {
+		t.Errorf("Get got: %q %v", e, ok)
+	}
+	s.Remove("guest")
+	if s.String() != "{Admin}" {
+		t.Errorf("Remove got: %v", s)
+	}
+	u := menge.NewCaseInsensitiveStringSet("admin", "Root")
+	for _, tc := range []struct {
+		name string
+		got  *menge.FoldedStringSet
+		want string
+	}{
+		{"Union", s.Union(u), "{Admin Root}"},
+		{"Union", u.Union(s), "{Root admin}"},
+		{"Intersection", s.Intersection(u), "{Admin}"},
+		{"Difference", u.Difference(s), "{Root}"},
+		{"SymmetricDifference", s.SymmetricDifference(u), "{Root}"},
+	} {
+		if tc.got.String() != tc.want || !tc.got.Has("ROOT") && strings.Contains(tc.want, "Root") {
+			t.Errorf("%s got: %v, want: %s", tc.name, tc.got, tc.want)
+		}
+	}
+	if !s.IsProperSubsetOf(u) || !u.IsSupersetOf(s) || s.IsDisjointFrom(u) || !s.Equals(menge.NewCaseInsensitiveStringSet("ADMIN")) {
+		t.Errorf("relations got different results")
+	}
+	if s.IntersectionSize(u) != 1 || s.UnionSize(u) != 2 || u.DifferenceSize(s) != 1 || s.Jaccard(u) != 0.5 {
+		t.Errorf("sizes got different results")
+	}
+	c := u.Clone()
+	c.UnionWith(menge.NewCaseInsensitiveStringSet("ROOT", "user"))
+	c.DifferenceWith(s)
+	if c.String() != "{Root user}" || u.Size() != 2 {
+		t.Errorf("UnionWith and DifferenceWith got: %v %v", c, u)
+	}
+	in, out := c.Partition(func(e string) bool { return e == "user" })
+	if !in.Has("USER") || !out.Has("root") {
+		t.Errorf("Partition kept the folding got: %v %v", in, out)
+	}
+	if got := c.ToStringSet(); !got.Equals(menge.NewStringSet("Root", "user")) {
+		t.Errorf("ToStringSet got: %v", got)
+	}
+}
+
+func TestFoldedStringSet_Folds(t *testing.T) {
+	tags := menge.NewFoldedStringSet(menge.ChainFolds(strings.TrimSpace, menge.FoldCase), " Go ", "go", "GO\n", "Rust")
+	if tags.Size() != 2 || !tags.Has("rust ") || tags.String() != "{ Go  Rust}" {
+		t.Errorf("got: %q", tags.AsSortedSlice())
+	}
+	var plain menge.FoldedStringSet
+	plain.Add("a", "A")
+	if plain.Size() != 2 || plain.Fold() != nil {
+		t.Errorf("zero value got: %v", &plain)
+	}
+	// A decomposed Å, composed by a stand-in for norm.NFC.String.
+	nfc := func(s string) string { return strings.ReplaceAll(s, "A\u030a", "\u00c5") }
+	names := menge.NewFoldedStringSet(menge.ChainFolds(nfc, menge.FoldCase), "\u00c5dmin")
+	if !names.Has("A\u030aDMIN") || names.Has("Admin") || names.String() != "{\u00c5dmin}" {
+		t.Errorf("custom fold got: %v", names)
+	}
+}
+
+func TestFoldedStringSet_Encoding(t *testing.T) {
+	s := menge.NewCaseInsensitiveStringSet("b", "A", "a")
+	if got := fmt.Sprintf("%v %+v %q %#v", s, s, s, s); got != `{A b} {A b} (size 2) {"A" "b"} menge.NewFoldedStringSet(fold, "A", "b")` {
+		t.Errorf("Format got: %s", got)
+	}
+	data, err := json.Marshal(s)
+	if err != nil || string(data) != `["A","b"]` {
+		t.Errorf("MarshalJSON got: %s %v", data, err)
+	}
+	if err := json.Unmarshal([]byte(`["X","x","y"]`), s); err != nil || !reflect.DeepEqual(s.AsSortedSlice(), []string{"X", "y"}) || !s.Has("Y") {
+		t.Errorf("UnmarshalJSON got: %v %v", s, err)
+	}
+}
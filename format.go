@@ -0,0 +1,120 @@
+package menge
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// format implements Format for s. constructor is used by the %#v verb.
+func (s Set[T]) format(f fmt.State, verb rune, constructor string) {
+	formatElems(f, verb, s.AsSortedSlice(), constructor)
+}
+
+// formatElems implements Format for a set with the ordered elements a.
+// constructor is used by the %#v verb.
+func formatElems[T any](f fmt.State, verb rune, a []T, constructor string) {
+	if verb == 'v' && f.Flag('#') {
+		io.WriteString(f, constructor+"(")
+		for i, e := range a {
+			if i != 0 {
+				io.WriteString(f, ", ")
+			}
+			io.WriteString(f, goSyntax(e))
+		}
+		io.WriteString(f, ")")
+		return
+	}
+	if verb == 's' {
+		verb = 'v'
+	}
+	writeElems(f, a, directive(f, verb), " ")
+	if verb == 'v' && f.Flag('+') {
+		fmt.Fprintf(f, " (size %d)", len(a))
+	}
+}
+
+// goSyntax returns the Go syntax of e, as formatted by the %#v verb, except
+// that the infinite and NaN parts of floats and complex numbers, which %#v
+// formats as +Inf and NaN, are written as calls to math.Inf and math.NaN,
+// e.g., math.Inf(-1) or float32(math.NaN()).
+func goSyntax(e any) string {
+	v := reflect.ValueOf(e)
+	var s string
+	switch k := v.Kind(); k {
+	case reflect.Float32, reflect.Float64:
+		x := v.Float()
+		if !math.IsInf(x, 0) && !math.IsNaN(x) {
+			return fmt.Sprintf("%#v", e)
+		}
+		if s = floatSyntax(x, 64); k == reflect.Float64 && v.Type().PkgPath() == "" {
+			return s
+		}
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		if !math.IsInf(real(c), 0) && !math.IsNaN(real(c)) && !math.IsInf(imag(c), 0) && !math.IsNaN(imag(c)) {
+			return fmt.Sprintf("%#v", e)
+		}
+		bits := 64
+		if k == reflect.Complex64 {
+			bits = 32
+		}
+		if s = "complex(" + floatSyntax(real(c), bits) + ", " + floatSyntax(imag(c), bits) + ")"; k == reflect.Complex128 && v.Type().PkgPath() == "" {
+			return s
+		}
+	default:
+		return fmt.Sprintf("%#v", e)
+	}
+	return fmt.Sprintf("%T(%s)", e, s)
+}
+
+// floatSyntax returns the Go syntax of x, a float of the given bit size,
+// with infinities and NaN written as calls to math.Inf and math.NaN.
+func floatSyntax(x float64, bits int) string {
+	switch {
+	case math.IsInf(x, 1):
+		return "math.Inf(1)"
+	case math.IsInf(x, -1):
+		return "math.Inf(-1)"
+	case math.IsNaN(x):
+		return "math.NaN()"
+	}
+	return strconv.FormatFloat(x, 'g', -1, bits)
+}
+
+// writeElems writes the elements in braces, each formatted with the
+// formatting directive d and separated by sep.
+func writeElems[T any](w io.Writer, a []T, d, sep string) {
+	io.WriteString(w, "{")
+	for i, e := range a {
+		if i != 0 {
+			io.WriteString(w, sep)
+		}
+		fmt.Fprintf(w, d, e)
+	}
+	io.WriteString(w, "}")
+}
+
+// directive reconstructs the formatting directive of f and verb,
+// e.g., %-8.2f.
+func directive(f fmt.State, verb rune) string {
+	b := &strings.Builder{}
+	b.WriteByte('%')
+	for _, c := range "+-# 0" {
+		if f.Flag(int(c)) {
+			b.WriteRune(c)
+		}
+	}
+	if w, ok := f.Width(); ok {
+		b.WriteString(strconv.Itoa(w))
+	}
+	if p, ok := f.Precision(); ok {
+		b.WriteByte('.')
+		b.WriteString(strconv.Itoa(p))
+	}
+	b.WriteRune(verb)
+	return b.String()
+}
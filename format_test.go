@@ -0,0 +1,76 @@
+package menge_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/soroushj/menge"
+)
+
+func TestSet_Format(t *testing.T) {
+	cases := []struct {
+		format string
+		set    any
+		want   string
+	}{
+		{"%v", menge.NewIntSet(), "{}"},
+		{"%v", menge.NewIntSet(3, -1, 20, 2), "{-1 2 3 20}"},
+		{"%s", menge.NewIntSet(2, 1), "{1 2}"},
+		{"%+v", menge.NewIntSet(2, 1), "{1 2} (size 2)"},
+		{"%+v", menge.NewIntSet(), "{} (size 0)"},
+		{"%#v", menge.NewIntSet(2, 1), "menge.NewIntSet(1, 2)"},
+		{"%#v", menge.NewIntSet(), "menge.NewIntSet()"},
+		{"%#v", menge.NewStringSet("b", "a"), `menge.NewStringSet("a", "b")`},
+		{"%#v", menge.NewUIntSet(16), "menge.NewUIntSet(0x10)"},
+		{"%#v", menge.NewSet[int](2, 1), "menge.NewSet[int](1, 2)"},
+		{"%#v", menge.NewSet(point{1, 2}), "menge.NewSet[menge_test.point](menge_test.point{x:1, y:2})"},
+		{"%#v", menge.NewFloat64Set(math.Inf(1), 1), "menge.NewFloat64Set(1, math.Inf(1))"},
+		{"%#v", menge.NewSet(math.NaN(), math.Inf(-1)), "menge.NewSet[float64](math.NaN(), math.Inf(-1))"},
+		{"%#v", menge.NewFloat32Set(float32(math.Inf(-1)), 0.5), "menge.NewFloat32Set(float32(math.Inf(-1)), 0.5)"},
+		{"%#v", menge.NewComplex64Set(complex(float32(math.Inf(1)), 0.1)), "menge.NewComplex64Set(complex64(complex(math.Inf(1), 0.1)))"},
+		{"%#v", menge.NewSet(complex(1, math.NaN()), 2i), "menge.NewSet[complex128]((0+2i), complex(1, math.NaN()))"},
+		{"%v", menge.NewStringSet("b", "a", "B", "ab"), "{B a ab b}"},
+		{"%q", menge.NewStringSet("b", "a c"), `{"a c" "b"}`},
+		{"%x", menge.NewIntSet(255, 16), "{10 ff}"},
+		{"%#x", menge.NewUInt8Set(255, 16), "{0x10 0xff}"},
+		{"%3d", menge.NewInt8Set(1, -1), "{ -1   1}"},
+		{"%.2f", menge.NewFloat64Set(0.5, 1.125), "{0.50 1.12}"},
+		{"%v", menge.NewFloat64Set(math.Inf(1), 1, math.Inf(-1), -0.5), "{-Inf -0.5 1 +Inf}"},
+		{"%v", menge.NewSet(1, math.NaN(), -1), "{NaN -1 1}"},
+		{"%v", menge.NewComplex128Set(complex(1, 2), complex(1, -1), complex(-1, 5)), "{(-1+5i) (1-1i) (1+2i)}"},
+		{"%v", menge.NewSet(true, false), "{false true}"},
+		{"%v", menge.NewSet(point{2, 1}, point{1, 2}), "{{1 2} {2 1}}"},
+		{"%+v", menge.NewSet(point{1, 2}), "{{x:1 y:2}} (size 1)"},
+	}
+	for _, c := range cases {
+		got := fmt.Sprintf(c.format, c.set)
+		if got != c.want {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func TestSet_String_Order(t *testing.T) {
+	type id int
+	type name string
+	type ratio float32
+	type phase complex64
+	type flag uint16
+	cases := []struct {
+		set  fmt.Stringer
+		want string
+	}{
+		{menge.NewSet[id](10, 2, -3), "{-3 2 10}"},
+		{menge.NewSet[name]("b", "a"), "{a b}"},
+		{menge.NewSet[ratio](0.5, -1), "{-1 0.5}"},
+		{menge.NewSet[phase](complex(0, 1), complex(0, -1)), "{(0-1i) (0+1i)}"},
+		{menge.NewSet[flag](8, 1), "{1 8}"},
+	}
+	for _, c := range cases {
+		got := c.set.String()
+		if got != c.want {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
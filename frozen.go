@@ -0,0 +1,284 @@
+package menge
+
+import (
+	"fmt"
+)
+
+// Queryable is a constraint that permits the mutable set types S of elements
+// of type T, such as Set[T], IntSet, *OrderedSet[T], and *SyncSet[T], by
+// their read-only methods. The methods that compute a new set return a new
+// mutable set of type S.
+type Queryable[S any, T comparable] interface {
+	Reader[T]
+	Format(f fmt.State, verb rune)
+	MarshalJSON() ([]byte, error)
+	Clone() S
+	Equals(t S) bool
+	Union(t S) S
+	Intersection(t S) S
+	Difference(t S) S
+	SymmetricDifference(t S) S
+	IsSubsetOf(t S) bool
+	IsProperSubsetOf(t S) bool
+	IsSupersetOf(t S) bool
+	IsProperSupersetOf(t S) bool
+	IsDisjointFrom(t S) bool
+	IntersectionSize(t S) int
+	UnionSize(t S) int
+	DifferenceSize(t S) int
+	Jaccard(t S) float64
+	SorensenDice(t S) float64
+	OverlapCoefficient(t S) float64
+	CosineSimilarity(t S) float64
+	Filter(pred func(elem T) bool) S
+	Partition(pred func(elem T) bool) (in, out S)
+}
+
+// Frozen is a read-only view of a set of type S, which exposes only the
+// read-only methods of S. The methods that compute a new set, such as Clone,
+// Union, and Filter, return a new mutable set of type S, which the caller
+// owns. A Frozen can be handed out to callers that should not modify the set.
+// It is a view rather than a copy: changes made to the set through S are
+// visible through the view, so freeze a clone of a set that is to change.
+// Frozen views are returned by the Freeze method of the mutable set types.
+// PersistentSet, which is immutable, needs no view.
+type Frozen[S Queryable[S, T], T comparable] struct {
+	s S
+}
+
+// Freeze returns a read-only view of s.
+func Freeze[S Queryable[S, T], T comparable](s S) Frozen[S, T] {
+	return Frozen[S, T]{s}
+}
+
+// Has indicates whether the set has an element.
+func (f Frozen[S, T]) Has(elem T) bool {
+	return f.s.Has(elem)
+}
+
+// Size returns the size of the set.
+func (f Frozen[S, T]) Size() int {
+	return f.s.Size()
+}
+
+// IsEmpty indicates whether the set is empty.
+func (f Frozen[S, T]) IsEmpty() bool {
+	return f.s.IsEmpty()
+}
+
+// Clone returns a mutable clone of the set.
+func (f Frozen[S, T]) Clone() S {
+	return f.s.Clone()
+}
+
+// AsSlice returns an equivalent slice, as the AsSlice method of S does.
+func (f Frozen[S, T]) AsSlice() []T {
+	return f.s.AsSlice()
+}
+
+// AsSortedSlice returns an equivalent slice with the elements in ascending
+// order.
+func (f Frozen[S, T]) AsSortedSlice() []T {
+	return f.s.AsSortedSlice()
+}
+
+// AsSortedSliceDesc returns an equivalent slice with the elements in
+// descending order.
+func (f Frozen[S, T]) AsSortedSliceDesc() []T {
+	return f.s.AsSortedSliceDesc()
+}
+
+// AppendTo appends the elements of the set to dst, as the AppendTo method
+// of S does, and returns the extended slice.
+func (f Frozen[S, T]) AppendTo(dst []T) []T {
+	return f.s.AppendTo(dst)
+}
+
+// String returns a string representation of the set.
+func (f Frozen[S, T]) String() string {
+	return f.s.String()
+}
+
+// Format implements the fmt.Formatter interface, as S does.
+func (f Frozen[S, T]) Format(state fmt.State, verb rune) {
+	f.s.Format(state, verb)
+}
+
+// MarshalJSON implements the json.Marshaler interface, as S does.
+func (f Frozen[S, T]) MarshalJSON() ([]byte, error) {
+	return f.s.MarshalJSON()
+}
+
+// Equals indicates whether f and t are equal.
+func (f Frozen[S, T]) Equals(t Frozen[S, T]) bool {
+	return f.s.Equals(t.s)
+}
+
+// Union returns the union of f and t as a new mutable set.
+func (f Frozen[S, T]) Union(t Frozen[S, T]) S {
+	return f.s.Union(t.s)
+}
+
+// Intersection returns the intersection of f and t as a new mutable set.
+func (f Frozen[S, T]) Intersection(t Frozen[S, T]) S {
+	return f.s.Intersection(t.s)
+}
+
+// Difference returns the difference of f and t, i.e., f - t,
+// as a new mutable set.
+func (f Frozen[S, T]) Difference(t Frozen[S, T]) S {
+	return f.s.Difference(t.s)
+}
+
+// SymmetricDifference returns the symmetric difference of f and t
+// as a new mutable set.
+func (f Frozen[S, T]) SymmetricDifference(t Frozen[S, T]) S {
+	return f.s.SymmetricDifference(t.s)
+}
+
+// IsSubsetOf indicates whether f is a subset of t.
+func (f Frozen[S, T]) IsSubsetOf(t Frozen[S, T]) bool {
+	return f.s.IsSubsetOf(t.s)
+}
+
+// IsProperSubsetOf indicates whether f is a proper subset of t.
+func (f Frozen[S, T]) IsProperSubsetOf(t Frozen[S, T]) bool {
+	return f.s.IsProperSubsetOf(t.s)
+}
+
+// IsSupersetOf indicates whether f is a superset of t.
+func (f Frozen[S, T]) IsSupersetOf(t Frozen[S, T]) bool {
+	return f.s.IsSupersetOf(t.s)
+}
+
+// IsProperSupersetOf indicates whether f is a proper superset of t.
+func (f Frozen[S, T]) IsProperSupersetOf(t Frozen[S, T]) bool {
+	return f.s.IsProperSupersetOf(t.s)
+}
+
+// IsDisjointFrom indicates whether f and t are disjoint.
+func (f Frozen[S, T]) IsDisjointFrom(t Frozen[S, T]) bool {
+	return f.s.IsDisjointFrom(t.s)
+}
+
+// IntersectionSize returns the size of the intersection of f and t.
+func (f Frozen[S, T]) IntersectionSize(t Frozen[S, T]) int {
+	return f.s.IntersectionSize(t.s)
+}
+
+// UnionSize returns the size of the union of f and t.
+func (f Frozen[S, T]) UnionSize(t Frozen[S, T]) int {
+	return f.s.UnionSize(t.s)
+}
+
+// DifferenceSize returns the size of the difference of f and t, i.e., f - t.
+func (f Frozen[S, T]) DifferenceSize(t Frozen[S, T]) int {
+	return f.s.DifferenceSize(t.s)
+}
+
+// Jaccard returns the Jaccard index of f and t.
+func (f Frozen[S, T]) Jaccard(t Frozen[S, T]) float64 {
+	return f.s.Jaccard(t.s)
+}
+
+// SorensenDice returns the Sørensen–Dice coefficient of f and t.
+func (f Frozen[S, T]) SorensenDice(t Frozen[S, T]) float64 {
+	return f.s.SorensenDice(t.s)
+}
+
+// OverlapCoefficient returns the overlap coefficient of f and t.
+func (f Frozen[S, T]) OverlapCoefficient(t Frozen[S, T]) float64 {
+	return f.s.OverlapCoefficient(t.s)
+}
+
+// CosineSimilarity returns the cosine similarity of f and t.
+func (f Frozen[S, T]) CosineSimilarity(t Frozen[S, T]) float64 {
+	return f.s.CosineSimilarity(t.s)
+}
+
+// Filter returns a new mutable set containing the elements of f for which
+// pred returns true.
+func (f Frozen[S, T]) Filter(pred func(elem T) bool) S {
+	return f.s.Filter(pred)
+}
+
+// Partition returns two new mutable sets containing the elements of f for
+// which pred returns true and false, respectively.
+func (f Frozen[S, T]) Partition(pred func(elem T) bool) (in, out S) {
+	return f.s.Partition(pred)
+}
+
+// Any indicates whether pred returns true for any element of f.
+func (f Frozen[S, T]) Any(pred func(elem T) bool) bool {
+	return f.s.Any(pred)
+}
+
+// Every indicates whether pred returns true for all elements of f.
+func (f Frozen[S, T]) Every(pred func(elem T) bool) bool {
+	return f.s.Every(pred)
+}
+
+// None indicates whether pred returns false for all elements of f.
+func (f Frozen[S, T]) None(pred func(elem T) bool) bool {
+	return f.s.None(pred)
+}
+
+// Count returns the number of elements of f for which pred returns true.
+func (f Frozen[S, T]) Count(pred func(elem T) bool) int {
+	return f.s.Count(pred)
+}
+
+// Freeze returns a read-only view of the set. See Frozen.
+func (s Set[T]) Freeze() Frozen[Set[T], T] {
+	return Freeze[Set[T], T](s)
+}
+
+// Freeze returns a read-only view of the set. See Frozen.
+func (s *SyncSet[T]) Freeze() Frozen[*SyncSet[T], T] {
+	return Freeze[*SyncSet[T], T](s)
+}
+
+// Freeze returns a read-only view of the set. See Frozen.
+func (s *ShardedSet[T]) Freeze() Frozen[*ShardedSet[T], T] {
+	return Freeze[*ShardedSet[T], T](s)
+}
+
+// Freeze returns a read-only view of the set. See Frozen.
+func (s *OrderedSet[T]) Freeze() Frozen[*OrderedSet[T], T] {
+	return Freeze[*OrderedSet[T], T](s)
+}
+
+// Freeze returns a read-only view of the set. See Frozen.
+func (s *BitSet[T]) Freeze() Frozen[*BitSet[T], T] {
+	return Freeze[*BitSet[T], T](s)
+}
+
+// Freeze returns a read-only view of the set. See Frozen.
+func (s *IntervalSet[T]) Freeze() Frozen[*IntervalSet[T], T] {
+	return Freeze[*IntervalSet[T], T](s)
+}
+
+// Freeze returns a read-only view of the set. See Frozen.
+func (s *RoaringUInt32Set) Freeze() Frozen[*RoaringUInt32Set, uint32] {
+	return Freeze[*RoaringUInt32Set, uint32](s)
+}
+
+// Freeze returns a read-only view of the set. See Frozen.
+func (s *RoaringUInt64Set) Freeze() Frozen[*RoaringUInt64Set, uint64] {
+	return Freeze[*RoaringUInt64Set, uint64](s)
+}
+
+// Freeze returns a read-only view of the set. See Frozen.
+func (s *TrieStringSet) Freeze() Frozen[*TrieStringSet, string] {
+	return Freeze[*TrieStringSet, string](s)
+}
+
+// Freeze returns a read-only view of the set. See Frozen.
+func (s *FoldedStringSet) Freeze() Frozen[*FoldedStringSet, string] {
+	return Freeze[*FoldedStringSet, string](s)
+}
+
+// Freeze returns a read-only view of the set. See Frozen.
+func (s *ApproxSet[T]) Freeze() Frozen[*ApproxSet[T], T] {
+	return Freeze[*ApproxSet[T], T](s)
+}
@@ -0,0 +1,103 @@
+package menge_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/soroushj/menge"
+)
+
+// sum only reads s, so it accepts any set of int.
+func sum(s menge.Reader[int]) int {
+	n := 0
+	for _, e := range s.AsSlice() {
+		n += e
+	}
+	return n
+}
+
+func TestReader(t *testing.T) {
+	o := menge.NewOrderedIntSet(1, 3, 5)
+	for _, s := range []menge.Reader[int]{
+		menge.NewSet(1, 3, 5),
+		menge.NewIntSet(1, 3, 5),
+		menge.NewSyncIntSet(1, 3, 5),
+		menge.NewShardedIntSet(1, 3, 5),
+		o,
+		menge.NewIntervalIntSet(1, 3, 5),
+		menge.NewPersistentIntSet(1, 3, 5),
+		menge.NewIntSet(1, 3, 5).Freeze(),
+		o.Freeze(),
+	} {
+		if got := sum(s); got != 9 || s.Size() != 3 || !s.Has(3) || s.String() != "{1 3 5}" {
+			t.Errorf("%T got: %v %v", s, got, s)
+		}
+	}
+}
+
+func TestFrozen(t *testing.T) {
+	sets := [][]int{{}, {1}, {1, 2}, {2, 3, 4}}
+	for _, a := range sets {
+		for _, b := range sets {
+			s, u := menge.NewIntSet(a...), menge.NewIntSet(b...)
+			f, g := s.Freeze(), u.Freeze()
+			if f.Equals(g) != s.Equals(u) || f.IsSubsetOf(g) != s.IsSubsetOf(u) || f.IsProperSupersetOf(g) != s.IsProperSupersetOf(u) ||
+				f.IsDisjointFrom(g) != s.IsDisjointFrom(u) || f.UnionSize(g) != s.UnionSize(u) || f.Jaccard(g) != s.Jaccard(u) {
+				t.Errorf("%v relations %v got different results", a, b)
+			}
+			for _, op := range []struct {
+				name string
+				f    func(f, g menge.FrozenIntSet) menge.IntSet
+				g    func(s, u menge.IntSet) menge.IntSet
+			}{
+				{"Union", menge.FrozenIntSet.Union, menge.IntSet.Union},
+				{"Intersection", menge.FrozenIntSet.Intersection, menge.IntSet.Intersection},
+				{"Difference", menge.FrozenIntSet.Difference, menge.IntSet.Difference},
+				{"SymmetricDifference", menge.FrozenIntSet.SymmetricDifference, menge.IntSet.SymmetricDifference},
+			} {
+				got := op.f(f, g)
+				if want := op.g(s, u); !got.Equals(want) {
+					t.Errorf("%v %s %v got: %v", a, op.name, b, got)
+				}
+				got.Add(100)
+				if s.Has(100) || u.Has(100) {
+					t.Errorf("%v %s %v did not return a new set", a, op.name, b)
+				}
+			}
+		}
+	}
+}
+
+func TestFrozen_View(t *testing.T) {
+	s := menge.NewOrderedStringSet("b", "a")
+	f := s.Freeze()
+	c := f.Clone()
+	c.Add("c")
+	if f.Size() != 2 || f.Has("c") {
+		t.Errorf("Clone modified the set: %v", f)
+	}
+	s.Add("z")
+	if !f.Has("z") || f.String() != "{a b z}" {
+		t.Errorf("view does not reflect changes: %v", f)
+	}
+	in, out := f.Partition(func(e string) bool { return e < "b" })
+	if in.String() != "{a}" || out.String() != "{b z}" || !reflect.DeepEqual(f.Filter(func(e string) bool { return e < "b" }).AsSlice(), []string{"a"}) {
+		t.Errorf("Partition got: %v %v", in, out)
+	}
+}
+
+func TestFrozen_Encoding(t *testing.T) {
+	f := menge.NewRoaringUInt32Set(3, 1).Freeze()
+	if got := fmt.Sprintf("%v %+v", f, f); got != "{1 3} {1 3} (size 2)" {
+		t.Errorf("Format got: %s", got)
+	}
+	data, err := json.Marshal(f)
+	if err != nil || string(data) != `[1,3]` {
+		t.Errorf("MarshalJSON got: %s %v", data, err)
+	}
+	if got := fmt.Sprint(menge.NewFloat64Set(2, 1).Freeze()); got != "{1 2}" {
+		t.Errorf("Float64Set got: %s", got)
+	}
+}
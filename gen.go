@@ -0,0 +1,19 @@
+package menge
+
+// The concrete set types are generated by mengegen and defined over Set.
+//go:generate go run ./cmd/mengegen -type complex128 -name Complex128Set -nan -generic -binary -iter -output complex128.go
+//go:generate go run ./cmd/mengegen -type complex64 -name Complex64Set -nan -generic -binary -iter -output complex64.go
+//go:generate go run ./cmd/mengegen -type float32 -name Float32Set -nan -generic -binary -iter -output float32.go
+//go:generate go run ./cmd/mengegen -type float64 -name Float64Set -nan -generic -binary -iter -output float64.go
+//go:generate go run ./cmd/mengegen -type int -name IntSet -generic -binary -iter -output int.go
+//go:generate go run ./cmd/mengegen -type int16 -name Int16Set -generic -binary -iter -output int16.go
+//go:generate go run ./cmd/mengegen -type int32 -name Int32Set -generic -binary -iter -output int32.go
+//go:generate go run ./cmd/mengegen -type int64 -name Int64Set -generic -binary -iter -output int64.go
+//go:generate go run ./cmd/mengegen -type int8 -name Int8Set -generic -binary -iter -output int8.go
+//go:generate go run ./cmd/mengegen -type string -name StringSet -generic -binary -iter -output string.go
+//go:generate go run ./cmd/mengegen -type uint -name UIntSet -generic -binary -iter -output uint.go
+//go:generate go run ./cmd/mengegen -type uint16 -name UInt16Set -generic -binary -iter -output uint16.go
+//go:generate go run ./cmd/mengegen -type uint32 -name UInt32Set -generic -binary -iter -output uint32.go
+//go:generate go run ./cmd/mengegen -type uint64 -name UInt64Set -generic -binary -iter -output uint64.go
+//go:generate go run ./cmd/mengegen -type uint8 -name UInt8Set -generic -binary -iter -output uint8.go
+//go:generate go run ./cmd/mengegen -type uintptr -name UIntPtrSet -generic -binary -iter -output uintptr.go
@@ -0,0 +1,29 @@
+// Code generated by mengegen. DO NOT EDIT.
+
+//go:build go1.23
+
+package menge
+
+import "iter"
+
+// All returns an iterator over the elements of the set,
+// with no specific order of the elements.
+func (s Int16Set) All() iter.Seq[int16] {
+	return Set[int16](s).All()
+}
+
+// Sorted returns an iterator over the elements of the set,
+// in their natural order, as used by String.
+// The elements are sorted when the iteration starts.
+func (s Int16Set) Sorted() iter.Seq[int16] {
+	return Set[int16](s).Sorted()
+}
+
+// CollectInt16Set returns a new Int16Set containing the elements of seq.
+func CollectInt16Set(seq iter.Seq[int16]) Int16Set {
+	s := NewInt16Set()
+	for e := range seq {
+		s.Add(e)
+	}
+	return s
+}
@@ -0,0 +1,29 @@
+// Code generated by mengegen. DO NOT EDIT.
+
+//go:build go1.23
+
+package menge
+
+import "iter"
+
+// All returns an iterator over the elements of the set,
+// with no specific order of the elements.
+func (s Int32Set) All() iter.Seq[int32] {
+	return Set[int32](s).All()
+}
+
+// Sorted returns an iterator over the elements of the set,
+// in their natural order, as used by String.
+// The elements are sorted when the iteration starts.
+func (s Int32Set) Sorted() iter.Seq[int32] {
+	return Set[int32](s).Sorted()
+}
+
+// CollectInt32Set returns a new Int32Set containing the elements of seq.
+func CollectInt32Set(seq iter.Seq[int32]) Int32Set {
+	s := NewInt32Set()
+	for e := range seq {
+		s.Add(e)
+	}
+	return s
+}
@@ -0,0 +1,29 @@
+// Code generated by mengegen. DO NOT EDIT.
+
+//go:build go1.23
+
+package menge
+
+import "iter"
+
+// All returns an iterator over the elements of the set,
+// with no specific order of the elements.
+func (s Int64Set) All() iter.Seq[int64] {
+	return Set[int64](s).All()
+}
+
+// Sorted returns an iterator over the elements of the set,
+// in their natural order, as used by String.
+// The elements are sorted when the iteration starts.
+func (s Int64Set) Sorted() iter.Seq[int64] {
+	return Set[int64](s).Sorted()
+}
+
+// CollectInt64Set returns a new Int64Set containing the elements of seq.
+func CollectInt64Set(seq iter.Seq[int64]) Int64Set {
+	s := NewInt64Set()
+	for e := range seq {
+		s.Add(e)
+	}
+	return s
+}
@@ -0,0 +1,29 @@
+// Code generated by mengegen. DO NOT EDIT.
+
+//go:build go1.23
+
+package menge
+
+import "iter"
+
+// All returns an iterator over the elements of the set,
+// with no specific order of the elements.
+func (s Int8Set) All() iter.Seq[int8] {
+	return Set[int8](s).All()
+}
+
+// Sorted returns an iterator over the elements of the set,
+// in their natural order, as used by String.
+// The elements are sorted when the iteration starts.
+func (s Int8Set) Sorted() iter.Seq[int8] {
+	return Set[int8](s).Sorted()
+}
+
+// CollectInt8Set returns a new Int8Set containing the elements of seq.
+func CollectInt8Set(seq iter.Seq[int8]) Int8Set {
+	s := NewInt8Set()
+	for e := range seq {
+		s.Add(e)
+	}
+	return s
+}
@@ -0,0 +1,29 @@
+// Code generated by mengegen. DO NOT EDIT.
+
+//go:build go1.23
+
+package menge
+
+import "iter"
+
+// All returns an iterator over the elements of the set,
+// with no specific order of the elements.
+func (s IntSet) All() iter.Seq[int] {
+	return Set[int](s).All()
+}
+
+// Sorted returns an iterator over the elements of the set,
+// in their natural order, as used by String.
+// The elements are sorted when the iteration starts.
+func (s IntSet) Sorted() iter.Seq[int] {
+	return Set[int](s).Sorted()
+}
+
+// CollectIntSet returns a new IntSet containing the elements of seq.
+func CollectIntSet(seq iter.Seq[int]) IntSet {
+	s := NewIntSet()
+	for e := range seq {
+		s.Add(e)
+	}
+	return s
+}
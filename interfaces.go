@@ -0,0 +1,61 @@
+package menge
+
+// Sized is the interface of the sets of any element type that report their
+// size. It is implemented by all set types of this package, so that, e.g.,
+// a function that logs the size of a set can accept any of them.
+type Sized interface {
+	Size() int
+	IsEmpty() bool
+	String() string
+}
+
+// Reader is the read-only interface of the sets of elements of type T.
+// It is implemented by all set types of this package and by their frozen
+// views, so that a function that only reads a set can accept any of them.
+type Reader[T comparable] interface {
+	Sized
+	Has(elem T) bool
+	AsSlice() []T
+	AsSortedSlice() []T
+	AsSortedSliceDesc() []T
+	AppendTo(dst []T) []T
+	Any(pred func(elem T) bool) bool
+	Every(pred func(elem T) bool) bool
+	None(pred func(elem T) bool) bool
+	Count(pred func(elem T) bool) int
+}
+
+// Writer is the interface of the sets of elements of type T that can be
+// modified in place. It is implemented by all set types of this package
+// except PersistentSet and the frozen views, and by Bag.
+type Writer[T comparable] interface {
+	Add(elems ...T)
+	Remove(elems ...T)
+	Empty()
+}
+
+// ReadWriter is the interface that groups Reader and Writer. It is implemented
+// by all mutable set types of this package.
+type ReadWriter[T comparable] interface {
+	Reader[T]
+	Writer[T]
+}
+
+var (
+	_ ReadWriter[int]        = Set[int](nil)
+	_ ReadWriter[int]        = (*SyncSet[int])(nil)
+	_ ReadWriter[int]        = (*ShardedSet[int])(nil)
+	_ ReadWriter[string]     = (*OrderedSet[string])(nil)
+	_ ReadWriter[uint8]      = (*BitSet[uint8])(nil)
+	_ ReadWriter[int]        = (*IntervalSet[int])(nil)
+	_ ReadWriter[uint32]     = (*RoaringUInt32Set)(nil)
+	_ ReadWriter[uint64]     = (*RoaringUInt64Set)(nil)
+	_ ReadWriter[string]     = (*TrieStringSet)(nil)
+	_ ReadWriter[string]     = (*FoldedStringSet)(nil)
+	_ ReadWriter[float64]    = (*ApproxSet[float64])(nil)
+	_ ReadWriter[complex128] = (*PolicySet[complex128])(nil)
+	_ Reader[int]            = PersistentSet[int]{}
+	_ Reader[int]            = Frozen[Set[int], int]{}
+	_ Writer[string]         = Bag[string](nil)
+	_ Sized                  = Bag[string](nil)
+)
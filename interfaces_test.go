@@ -0,0 +1,56 @@
+package menge_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/soroushj/menge"
+)
+
+// describe accepts a set of any element type.
+func describe(s menge.Sized) string {
+	return fmt.Sprintf("%d %v %s", s.Size(), s.IsEmpty(), s)
+}
+
+func TestSized(t *testing.T) {
+	for _, tc := range []struct {
+		s    menge.Sized
+		want string
+	}{
+		{menge.NewIntSet(), "0 true {}"},
+		{menge.NewStringSet("a"), "1 false {a}"},
+		{menge.NewBitUInt8Set(1, 2), "2 false {1 2}"},
+		{menge.NewPersistentFloat64Set(1.5), "1 false {1.5}"},
+		{menge.NewRoaringUInt64Set(7).Freeze(), "1 false {7}"},
+	} {
+		if got := describe(tc.s); got != tc.want {
+			t.Errorf("%T got: %s", tc.s, got)
+		}
+	}
+}
+
+// fill adds elems to any mutable set of int.
+func fill(s menge.IntReadWriter, elems ...int) int {
+	s.Remove(0)
+	s.Add(elems...)
+	return s.Size()
+}
+
+func TestReadWriter(t *testing.T) {
+	for _, s := range []menge.IntReadWriter{
+		menge.NewIntSet(0),
+		menge.NewSet(0),
+		menge.NewSyncIntSet(0),
+		menge.NewShardedIntSet(0),
+		menge.NewOrderedIntSet(0),
+		menge.NewIntervalIntSet(0),
+	} {
+		if got := fill(s, 1, 2, 2); got != 2 || s.Has(0) || s.String() != "{1 2}" && s.String() != "{1..2}" {
+			t.Errorf("%T got: %v", s, s)
+		}
+		s.Empty()
+		if !s.IsEmpty() {
+			t.Errorf("%T Empty got: %v", s, s)
+		}
+	}
+}
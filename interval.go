@@ -0,0 +1,763 @@
+package menge
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Interval represents the closed interval of integers from Lo to Hi,
+// inclusive. An interval with Lo > Hi is empty.
+type Interval[T Integer] struct {
+	Lo, Hi T
+}
+
+// IntervalSet represents a set of integers as a sorted slice of disjoint
+// closed intervals, so that a set of many consecutive integers, such as all
+// ports from 1024 to 65535 except 8080, takes the space of a few intervals.
+// The intervals are coalesced: no two of them overlap or are adjacent.
+// Has takes logarithmic time in the number of intervals, and the binary set
+// operations are linear merges of the intervals.
+// As a set may have more elements than an int can count, such as all int64
+// values, the sizes are saturated at math.MaxInt.
+// The zero value is an empty set ready to use.
+type IntervalSet[T Integer] struct {
+	ranges []Interval[T]
+}
+
+// search returns the index of the first interval of the set whose Hi is
+// greater than or equal to elem.
+func (s *IntervalSet[T]) search(elem T) int {
+	return sort.Search(len(s.ranges), func(i int) bool { return s.ranges[i].Hi >= elem })
+}
+
+// splice replaces the intervals of s from i to j, exclusive, with r.
+func (s *IntervalSet[T]) splice(i, j int, r ...Interval[T]) {
+	tail := s.ranges[j:]
+	if len(r) > j-i {
+		tail = append([]Interval[T](nil), tail...)
+	}
+	s.ranges = append(append(s.ranges[:i], r...), tail...)
+}
+
+// AddRange adds the elements from lo to hi, inclusive, to the set.
+// It adds no elements if lo > hi.
+func (s *IntervalSet[T]) AddRange(lo, hi T) {
+	if lo > hi {
+		return
+	}
+	// The intervals from i to j, exclusive, overlap or are adjacent to [lo, hi].
+	i := sort.Search(len(s.ranges), func(i int) bool {
+		return s.ranges[i].Hi >= lo || s.ranges[i].Hi+1 == lo
+	})
+	j := sort.Search(len(s.ranges), func(j int) bool {
+		return s.ranges[j].Lo > hi && s.ranges[j].Lo-1 != hi
+	})
+	if i < j {
+		if s.ranges[i].Lo < lo {
+			lo = s.ranges[i].Lo
+		}
+		if s.ranges[j-1].Hi > hi {
+			hi = s.ranges[j-1].Hi
+		}
+	}
+	s.splice(i, j, Interval[T]{lo, hi})
+}
+
+// RemoveRange removes the elements from lo to hi, inclusive, from the set.
+// It removes no elements if lo > hi.
+func (s *IntervalSet[T]) RemoveRange(lo, hi T) {
+	if lo > hi {
+		return
+	}
+	// The intervals from i to j, exclusive, overlap [lo, hi].
+	i := s.search(lo)
+	j := sort.Search(len(s.ranges), func(j int) bool { return s.ranges[j].Lo > hi })
+	if i == j {
+		return
+	}
+	var r []Interval[T]
+	if s.ranges[i].Lo < lo {
+		r = append(r, Interval[T]{s.ranges[i].Lo, lo - 1})
+	}
+	if s.ranges[j-1].Hi > hi {
+		r = append(r, Interval[T]{hi + 1, s.ranges[j-1].Hi})
+	}
+	s.splice(i, j, r...)
+}
+
+// Add adds zero or more elements to the set.
+func (s *IntervalSet[T]) Add(elems ...T) {
+	for _, e := range elems {
+		s.AddRange(e, e)
+	}
+}
+
+// Remove removes zero or more elements from the set.
+func (s *IntervalSet[T]) Remove(elems ...T) {
+	for _, e := range elems {
+		s.RemoveRange(e, e)
+	}
+}
+
+// Empty empties the set.
+func (s *IntervalSet[T]) Empty() {
+	s.ranges = nil
+}
+
+// Has indicates whether the set has an element.
+func (s *IntervalSet[T]) Has(elem T) bool {
+	i := s.search(elem)
+	return i < len(s.ranges) && s.ranges[i].Lo <= elem
+}
+
+// HasRange indicates whether the set has all elements from lo to hi,
+// inclusive. It returns true if lo > hi.
+func (s *IntervalSet[T]) HasRange(lo, hi T) bool {
+	if lo > hi {
+		return true
+	}
+	i := s.search(lo)
+	return i < len(s.ranges) && s.ranges[i].Lo <= lo && s.ranges[i].Hi >= hi
+}
+
+// Size returns the size of the set, or math.MaxInt if it is greater.
+func (s *IntervalSet[T]) Size() int {
+	return sizeOf(s.ranges)
+}
+
+// sizeOf returns the number of elements of the disjoint intervals rs,
+// or math.MaxInt if it is greater.
+func sizeOf[T Integer](rs []Interval[T]) int {
+	var n uint64
+	for _, r := range rs {
+		// The subtraction is done in uint64 so that it does not overflow
+		// for signed types; it wraps to 0 only for the full 64-bit range.
+		m := uint64(r.Hi) - uint64(r.Lo) + 1
+		if m == 0 || n+m < n || n+m > math.MaxInt {
+			return math.MaxInt
+		}
+		n += m
+	}
+	return int(n)
+}
+
+// IsEmpty indicates whether the set is empty.
+func (s *IntervalSet[T]) IsEmpty() bool {
+	return len(s.ranges) == 0
+}
+
+// Clone returns a clone of the set.
+func (s *IntervalSet[T]) Clone() *IntervalSet[T] {
+	return &IntervalSet[T]{append([]Interval[T](nil), s.ranges...)}
+}
+
+// Ranges returns the intervals of the set in ascending order.
+func (s *IntervalSet[T]) Ranges() []Interval[T] {
+	return append([]Interval[T](nil), s.ranges...)
+}
+
+// Min returns the least element of the set,
+// or false if the set is empty.
+func (s *IntervalSet[T]) Min() (T, bool) {
+	if len(s.ranges) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s.ranges[0].Lo, true
+}
+
+// Max returns the greatest element of the set,
+// or false if the set is empty.
+func (s *IntervalSet[T]) Max() (T, bool) {
+	if len(s.ranges) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s.ranges[len(s.ranges)-1].Hi, true
+}
+
+// Ascend calls f for each element of the set in ascending order,
+// until f returns false.
+func (s *IntervalSet[T]) Ascend(f func(elem T) bool) {
+	s.ascend(f)
+}
+
+// ascend is Ascend, reporting whether f never returned false.
+func (s *IntervalSet[T]) ascend(f func(elem T) bool) bool {
+	for _, r := range s.ranges {
+		// The loop tests e == r.Hi before incrementing e so that it does not
+		// overflow when r.Hi is the greatest value of T.
+		for e := r.Lo; ; e++ {
+			if !f(e) {
+				return false
+			}
+			if e == r.Hi {
+				break
+			}
+		}
+	}
+	return true
+}
+
+// Descend calls f for each element of the set in descending order,
+// until f returns false.
+func (s *IntervalSet[T]) Descend(f func(elem T) bool) {
+	for i := len(s.ranges) - 1; i >= 0; i-- {
+		r := s.ranges[i]
+		for e := r.Hi; ; e-- {
+			if !f(e) {
+				return
+			}
+			if e == r.Lo {
+				break
+			}
+		}
+	}
+}
+
+// AscendRanges calls f for each interval of the set in ascending order,
+// until f returns false.
+func (s *IntervalSet[T]) AscendRanges(f func(lo, hi T) bool) {
+	for _, r := range s.ranges {
+		if !f(r.Lo, r.Hi) {
+			return
+		}
+	}
+}
+
+// maxPrealloc is the maximum number of elements for which the conversions
+// of an IntervalSet preallocate, as the size of a set may be far more than
+// can be allocated, or saturated at math.MaxInt.
+const maxPrealloc = 1 << 16
+
+// prealloc returns the number of elements to preallocate for a conversion of
+// the set: its size, up to maxPrealloc.
+func (s *IntervalSet[T]) prealloc() int {
+	if n := s.Size(); n < maxPrealloc {
+		return n
+	}
+	return maxPrealloc
+}
+
+// AsSlice returns an equivalent slice with the elements in ascending order.
+// It has an element for each element of the set, so it is only meant for
+// small sets; use Ranges to get the intervals instead.
+func (s *IntervalSet[T]) AsSlice() []T {
+	return s.AppendTo(make([]T, 0, s.prealloc()))
+}
+
+// AsSortedSlice returns an equivalent slice with the elements in ascending
+// order. It is the same as AsSlice.
+func (s *IntervalSet[T]) AsSortedSlice() []T {
+	return s.AsSlice()
+}
+
+// AsSortedSliceDesc returns an equivalent slice with the elements in
+// descending order. Like AsSlice, it is only meant for small sets.
+func (s *IntervalSet[T]) AsSortedSliceDesc() []T {
+	a := make([]T, 0, s.prealloc())
+	s.Descend(func(e T) bool {
+		a = append(a, e)
+		return true
+	})
+	return a
+}
+
+// AppendTo appends the elements of the set to dst in ascending order and
+// returns the extended slice. It allocates only if dst does not have enough
+// capacity.
+func (s *IntervalSet[T]) AppendTo(dst []T) []T {
+	s.ascend(func(e T) bool {
+		dst = append(dst, e)
+		return true
+	})
+	return dst
+}
+
+// ToSet returns an equivalent Set. It can be converted to the set type of T,
+// e.g., IntSet(s.ToSet()) for an IntervalIntSet. Like AsSlice, it is only
+// meant for small sets.
+func (s *IntervalSet[T]) ToSet() Set[T] {
+	r := make(Set[T], s.prealloc())
+	s.ascend(func(e T) bool {
+		r[e] = struct{}{}
+		return true
+	})
+	return r
+}
+
+// String returns a string representation of the set, with its intervals in
+// ascending order. Intervals of more than one element are written as lo..hi.
+func (s *IntervalSet[T]) String() string {
+	b := &strings.Builder{}
+	s.writeRanges(b, "%v")
+	return b.String()
+}
+
+// writeRanges writes the intervals in braces, separated by spaces,
+// with their bounds formatted with the formatting directive d.
+func (s *IntervalSet[T]) writeRanges(w io.Writer, d string) {
+	io.WriteString(w, "{")
+	for i, r := range s.ranges {
+		if i != 0 {
+			io.WriteString(w, " ")
+		}
+		fmt.Fprintf(w, d, r.Lo)
+		if r.Hi != r.Lo {
+			io.WriteString(w, "..")
+			fmt.Fprintf(w, d, r.Hi)
+		}
+	}
+	io.WriteString(w, "}")
+}
+
+// Format implements the fmt.Formatter interface.
+// It supports the verbs of Set.Format, applying them to the bounds of the
+// intervals as written by String. The %#v verb formats the set as a call to
+// NewIntervalSetFromRanges.
+func (s *IntervalSet[T]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		var zero T
+		fmt.Fprintf(f, "menge.NewIntervalSetFromRanges[%T](", zero)
+		for i, r := range s.ranges {
+			if i != 0 {
+				io.WriteString(f, ", ")
+			}
+			fmt.Fprintf(f, "%#v", r)
+		}
+		io.WriteString(f, ")")
+		return
+	}
+	if verb == 's' {
+		verb = 'v'
+	}
+	s.writeRanges(f, directive(f, verb))
+	if verb == 'v' && f.Flag('+') {
+		fmt.Fprintf(f, " (size %d)", s.Size())
+	}
+}
+
+// Equals indicates whether s and t are equal.
+func (s *IntervalSet[T]) Equals(t *IntervalSet[T]) bool {
+	if len(s.ranges) != len(t.ranges) {
+		return false
+	}
+	for i, r := range s.ranges {
+		if r != t.ranges[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// appendRange appends r to the coalesced intervals rs, whose last interval
+// does not start after r, merging it with the last interval if they overlap
+// or are adjacent.
+func appendRange[T Integer](rs []Interval[T], r Interval[T]) []Interval[T] {
+	if k := len(rs) - 1; k >= 0 && (r.Lo <= rs[k].Hi || r.Lo-1 == rs[k].Hi) {
+		if r.Hi > rs[k].Hi {
+			rs[k].Hi = r.Hi
+		}
+		return rs
+	}
+	return append(rs, r)
+}
+
+// intersect calls f for each non-empty intersection of an interval of a with
+// an interval of b, in ascending order.
+func intersect[T Integer](a, b []Interval[T], f func(r Interval[T])) {
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		r := a[i]
+		if b[j].Lo > r.Lo {
+			r.Lo = b[j].Lo
+		}
+		if b[j].Hi < r.Hi {
+			r.Hi = b[j].Hi
+		}
+		if r.Lo <= r.Hi {
+			f(r)
+		}
+		if a[i].Hi < b[j].Hi {
+			i++
+		} else {
+			j++
+		}
+	}
+}
+
+// Union returns the union of s and t.
+func (s *IntervalSet[T]) Union(t *IntervalSet[T]) *IntervalSet[T] {
+	rs := make([]Interval[T], 0, len(s.ranges)+len(t.ranges))
+	i, j := 0, 0
+	for i < len(s.ranges) || j < len(t.ranges) {
+		if j == len(t.ranges) || i < len(s.ranges) && s.ranges[i].Lo < t.ranges[j].Lo {
+			rs = appendRange(rs, s.ranges[i])
+			i++
+		} else {
+			rs = appendRange(rs, t.ranges[j])
+			j++
+		}
+	}
+	return &IntervalSet[T]{rs}
+}
+
+// Intersection returns the intersection of s and t.
+func (s *IntervalSet[T]) Intersection(t *IntervalSet[T]) *IntervalSet[T] {
+	var rs []Interval[T]
+	intersect(s.ranges, t.ranges, func(r Interval[T]) {
+		rs = append(rs, r)
+	})
+	return &IntervalSet[T]{rs}
+}
+
+// Difference returns the difference of s and t, i.e., s - t.
+func (s *IntervalSet[T]) Difference(t *IntervalSet[T]) *IntervalSet[T] {
+	if len(s.ranges) == 0 {
+		return &IntervalSet[T]{}
+	}
+	return s.Intersection(t.Complement(s.ranges[0].Lo, s.ranges[len(s.ranges)-1].Hi))
+}
+
+// SymmetricDifference returns the symmetric difference of s and t,
+// i.e., the elements that are in either s or t but not in both.
+func (s *IntervalSet[T]) SymmetricDifference(t *IntervalSet[T]) *IntervalSet[T] {
+	return s.Union(t).Difference(s.Intersection(t))
+}
+
+// Complement returns the elements from lo to hi, inclusive, that are not in
+// the set, i.e., the complement of the set in the universe [lo, hi].
+// It returns an empty set if lo > hi.
+func (s *IntervalSet[T]) Complement(lo, hi T) *IntervalSet[T] {
+	r := &IntervalSet[T]{}
+	if lo > hi {
+		return r
+	}
+	next := lo
+	for _, x := range s.ranges[s.search(lo):] {
+		if x.Lo > hi {
+			break
+		}
+		if x.Lo > next {
+			r.ranges = append(r.ranges, Interval[T]{next, x.Lo - 1})
+		}
+		if x.Hi >= hi {
+			return r
+		}
+		next = x.Hi + 1
+	}
+	r.ranges = append(r.ranges, Interval[T]{next, hi})
+	return r
+}
+
+// UnionWith adds the elements of t to s, i.e., s = s ⋃ t.
+func (s *IntervalSet[T]) UnionWith(t *IntervalSet[T]) {
+	*s = *s.Union(t)
+}
+
+// IntersectWith removes the elements of s that are not in t, i.e., s = s ⋂ t.
+func (s *IntervalSet[T]) IntersectWith(t *IntervalSet[T]) {
+	*s = *s.Intersection(t)
+}
+
+// DifferenceWith removes the elements of t from s, i.e., s = s - t.
+func (s *IntervalSet[T]) DifferenceWith(t *IntervalSet[T]) {
+	*s = *s.Difference(t)
+}
+
+// SymmetricDifferenceWith replaces s with the symmetric difference of s and t,
+// i.e., removes the elements of t that are in s and adds those that are not.
+func (s *IntervalSet[T]) SymmetricDifferenceWith(t *IntervalSet[T]) {
+	*s = *s.SymmetricDifference(t)
+}
+
+// IsSubsetOf indicates whether s is a subset of t.
+func (s *IntervalSet[T]) IsSubsetOf(t *IntervalSet[T]) bool {
+	// As the intervals of t are coalesced, each interval of s must be within
+	// a single interval of t.
+	for _, r := range s.ranges {
+		if !t.HasRange(r.Lo, r.Hi) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsProperSubsetOf indicates whether s is a proper subset of t.
+func (s *IntervalSet[T]) IsProperSubsetOf(t *IntervalSet[T]) bool {
+	return s.IsSubsetOf(t) && !s.Equals(t)
+}
+
+// IsSupersetOf indicates whether s is a superset of t.
+func (s *IntervalSet[T]) IsSupersetOf(t *IntervalSet[T]) bool {
+	return t.IsSubsetOf(s)
+}
+
+// IsProperSupersetOf indicates whether s is a proper superset of t.
+func (s *IntervalSet[T]) IsProperSupersetOf(t *IntervalSet[T]) bool {
+	return t.IsProperSubsetOf(s)
+}
+
+// IsDisjointFrom indicates whether s and t are disjoint.
+func (s *IntervalSet[T]) IsDisjointFrom(t *IntervalSet[T]) bool {
+	disjoint := true
+	intersect(s.ranges, t.ranges, func(Interval[T]) {
+		disjoint = false
+	})
+	return disjoint
+}
+
+// IntersectionSize returns the size of the intersection of s and t,
+// or math.MaxInt if it is greater.
+func (s *IntervalSet[T]) IntersectionSize(t *IntervalSet[T]) int {
+	n := 0
+	intersect(s.ranges, t.ranges, func(r Interval[T]) {
+		if m := sizeOf([]Interval[T]{r}); n > math.MaxInt-m {
+			n = math.MaxInt
+		} else {
+			n += m
+		}
+	})
+	return n
+}
+
+// UnionSize returns the size of the union of s and t,
+// or math.MaxInt if it is greater.
+func (s *IntervalSet[T]) UnionSize(t *IntervalSet[T]) int {
+	return s.Union(t).Size()
+}
+
+// DifferenceSize returns the size of the difference of s and t, i.e., s - t,
+// or math.MaxInt if it is greater.
+func (s *IntervalSet[T]) DifferenceSize(t *IntervalSet[T]) int {
+	return s.Difference(t).Size()
+}
+
+// Jaccard returns the Jaccard index of s and t, i.e., |s ⋂ t| / |s ⋃ t|.
+// The Jaccard index of two empty sets is 1.
+func (s *IntervalSet[T]) Jaccard(t *IntervalSet[T]) float64 {
+	return jaccard(s.IntersectionSize(t), s.Size(), t.Size())
+}
+
+// SorensenDice returns the Sørensen–Dice coefficient of s and t,
+// i.e., 2|s ⋂ t| / (|s| + |t|).
+// The Sørensen–Dice coefficient of two empty sets is 1.
+func (s *IntervalSet[T]) SorensenDice(t *IntervalSet[T]) float64 {
+	return sorensenDice(s.IntersectionSize(t), s.Size(), t.Size())
+}
+
+// OverlapCoefficient returns the overlap coefficient of s and t,
+// i.e., |s ⋂ t| / min(|s|, |t|).
+// The overlap coefficient of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s *IntervalSet[T]) OverlapCoefficient(t *IntervalSet[T]) float64 {
+	return overlapCoefficient(s.IntersectionSize(t), s.Size(), t.Size())
+}
+
+// CosineSimilarity returns the cosine similarity of s and t,
+// i.e., |s ⋂ t| / √(|s||t|).
+// The cosine similarity of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s *IntervalSet[T]) CosineSimilarity(t *IntervalSet[T]) float64 {
+	return cosineSimilarity(s.IntersectionSize(t), s.Size(), t.Size())
+}
+
+// Filter returns a new set containing the elements of s for which pred
+// returns true. Like the other predicate methods, it calls pred for each
+// element of s rather than for each interval.
+func (s *IntervalSet[T]) Filter(pred func(elem T) bool) *IntervalSet[T] {
+	r := &IntervalSet[T]{}
+	s.ascend(func(e T) bool {
+		if pred(e) {
+			r.ranges = appendRange(r.ranges, Interval[T]{e, e})
+		}
+		return true
+	})
+	return r
+}
+
+// Retain removes the elements of s for which pred returns false.
+func (s *IntervalSet[T]) Retain(pred func(elem T) bool) {
+	*s = *s.Filter(pred)
+}
+
+// RemoveIf removes the elements of s for which pred returns true.
+func (s *IntervalSet[T]) RemoveIf(pred func(elem T) bool) {
+	*s = *s.Filter(func(e T) bool { return !pred(e) })
+}
+
+// Partition returns two new sets containing the elements of s for which pred
+// returns true and false, respectively.
+func (s *IntervalSet[T]) Partition(pred func(elem T) bool) (in, out *IntervalSet[T]) {
+	in = s.Filter(pred)
+	return in, s.Difference(in)
+}
+
+// Any indicates whether pred returns true for any element of s.
+// It returns false for an empty set.
+func (s *IntervalSet[T]) Any(pred func(elem T) bool) bool {
+	return !s.None(pred)
+}
+
+// Every indicates whether pred returns true for all elements of s.
+// It returns true for an empty set.
+func (s *IntervalSet[T]) Every(pred func(elem T) bool) bool {
+	return s.ascend(pred)
+}
+
+// None indicates whether pred returns false for all elements of s.
+// It returns true for an empty set.
+func (s *IntervalSet[T]) None(pred func(elem T) bool) bool {
+	return s.ascend(func(e T) bool { return !pred(e) })
+}
+
+// Count returns the number of elements of s for which pred returns true.
+func (s *IntervalSet[T]) Count(pred func(elem T) bool) int {
+	n := 0
+	s.ascend(func(e T) bool {
+		if pred(e) {
+			n++
+		}
+		return true
+	})
+	return n
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The set is encoded as an array of the [lo, hi] pairs of its intervals in
+// ascending order, e.g., [[1,3],[5,5]], rather than of its elements.
+func (s *IntervalSet[T]) MarshalJSON() ([]byte, error) {
+	a := make([][2]T, len(s.ranges))
+	for i, r := range s.ranges {
+		a[i] = [2]T{r.Lo, r.Hi}
+	}
+	return json.Marshal(a)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It decodes an array of [lo, hi] pairs, which may be unordered, overlapping,
+// or empty, and replaces the elements of the set with their union.
+func (s *IntervalSet[T]) UnmarshalJSON(data []byte) error {
+	var a [][2]T
+	if err := json.Unmarshal(data, &a); err != nil || a == nil {
+		return err
+	}
+	t := &IntervalSet[T]{}
+	for _, r := range a {
+		t.AddRange(r[0], r[1])
+	}
+	*s = *t
+	return nil
+}
+
+// NewIntervalSet returns a new IntervalSet containing zero or more elements.
+func NewIntervalSet[T Integer](elems ...T) *IntervalSet[T] {
+	s := &IntervalSet[T]{}
+	s.Add(elems...)
+	return s
+}
+
+// NewIntervalSetFromRanges returns a new IntervalSet containing the elements
+// of zero or more intervals, which may be unordered, overlapping, or empty.
+func NewIntervalSetFromRanges[T Integer](ranges ...Interval[T]) *IntervalSet[T] {
+	s := &IntervalSet[T]{}
+	for _, r := range ranges {
+		s.AddRange(r.Lo, r.Hi)
+	}
+	return s
+}
+
+// NewIntervalSetFromSet returns a new IntervalSet containing the elements of
+// s, which can be a Set or any set type of integers, such as IntSet.
+func NewIntervalSetFromSet[S ~map[T]struct{}, T Integer](s S) *IntervalSet[T] {
+	r := &IntervalSet[T]{}
+	for _, e := range Set[T](s).AsSortedSlice() {
+		r.ranges = appendRange(r.ranges, Interval[T]{e, e})
+	}
+	return r
+}
+
+// Interval sets of all integer types.
+type (
+	// IntervalIntSet represents an interval set of int elements.
+	IntervalIntSet = IntervalSet[int]
+	// IntervalInt16Set represents an interval set of int16 elements.
+	IntervalInt16Set = IntervalSet[int16]
+	// IntervalInt32Set represents an interval set of int32 elements.
+	IntervalInt32Set = IntervalSet[int32]
+	// IntervalInt64Set represents an interval set of int64 elements.
+	IntervalInt64Set = IntervalSet[int64]
+	// IntervalInt8Set represents an interval set of int8 elements.
+	IntervalInt8Set = IntervalSet[int8]
+	// IntervalUIntSet represents an interval set of uint elements.
+	IntervalUIntSet = IntervalSet[uint]
+	// IntervalUInt16Set represents an interval set of uint16 elements.
+	IntervalUInt16Set = IntervalSet[uint16]
+	// IntervalUInt32Set represents an interval set of uint32 elements.
+	IntervalUInt32Set = IntervalSet[uint32]
+	// IntervalUInt64Set represents an interval set of uint64 elements.
+	IntervalUInt64Set = IntervalSet[uint64]
+	// IntervalUInt8Set represents an interval set of uint8 elements.
+	IntervalUInt8Set = IntervalSet[uint8]
+	// IntervalUIntPtrSet represents an interval set of uintptr elements.
+	IntervalUIntPtrSet = IntervalSet[uintptr]
+)
+
+// NewIntervalIntSet returns a new IntervalIntSet containing zero or more elements.
+func NewIntervalIntSet(elems ...int) *IntervalIntSet {
+	return NewIntervalSet(elems...)
+}
+
+// NewIntervalInt16Set returns a new IntervalInt16Set containing zero or more elements.
+func NewIntervalInt16Set(elems ...int16) *IntervalInt16Set {
+	return NewIntervalSet(elems...)
+}
+
+// NewIntervalInt32Set returns a new IntervalInt32Set containing zero or more elements.
+func NewIntervalInt32Set(elems ...int32) *IntervalInt32Set {
+	return NewIntervalSet(elems...)
+}
+
+// NewIntervalInt64Set returns a new IntervalInt64Set containing zero or more elements.
+func NewIntervalInt64Set(elems ...int64) *IntervalInt64Set {
+	return NewIntervalSet(elems...)
+}
+
+// NewIntervalInt8Set returns a new IntervalInt8Set containing zero or more elements.
+func NewIntervalInt8Set(elems ...int8) *IntervalInt8Set {
+	return NewIntervalSet(elems...)
+}
+
+// NewIntervalUIntSet returns a new IntervalUIntSet containing zero or more elements.
+func NewIntervalUIntSet(elems ...uint) *IntervalUIntSet {
+	return NewIntervalSet(elems...)
+}
+
+// NewIntervalUInt16Set returns a new IntervalUInt16Set containing zero or more elements.
+func NewIntervalUInt16Set(elems ...uint16) *IntervalUInt16Set {
+	return NewIntervalSet(elems...)
+}
+
+// NewIntervalUInt32Set returns a new IntervalUInt32Set containing zero or more elements.
+func NewIntervalUInt32Set(elems ...uint32) *IntervalUInt32Set {
+	return NewIntervalSet(elems...)
+}
+
+// NewIntervalUInt64Set returns a new IntervalUInt64Set containing zero or more elements.
+func NewIntervalUInt64Set(elems ...uint64) *IntervalUInt64Set {
+	return NewIntervalSet(elems...)
+}
+
+// NewIntervalUInt8Set returns a new IntervalUInt8Set containing zero or more elements.
+func NewIntervalUInt8Set(elems ...uint8) *IntervalUInt8Set {
+	return NewIntervalSet(elems...)
+}
+
+// NewIntervalUIntPtrSet returns a new IntervalUIntPtrSet containing zero or more elements.
+func NewIntervalUIntPtrSet(elems ...uintptr) *IntervalUIntPtrSet {
+	return NewIntervalSet(elems...)
+}
@@ -0,0 +1,259 @@
+package menge_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/soroushj/menge"
+)
+
+// checkRanges checks that the intervals of s are non-empty, ascending and
+// coalesced, and that s has the elements of m.
+func checkRanges(t *testing.T, s *menge.IntervalInt8Set, m menge.Set[int8]) {
+	t.Helper()
+	rs := s.Ranges()
+	for i, r := range rs {
+		if r.Lo > r.Hi || i > 0 && int(r.Lo) <= int(rs[i-1].Hi)+1 {
+			t.Fatalf("intervals are not coalesced: %v", rs)
+		}
+	}
+	if got, want := s.AsSlice(), m.AsSortedSlice(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got: %v, want: %v", s, want)
+	}
+}
+
+// TestIntervalSet_Random compares IntervalSet with Set after random range
+// additions and removals, including at the bounds of int8.
+func TestIntervalSet_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	random := func() int8 {
+		if r.Intn(8) == 0 {
+			return []int8{math.MinInt8, math.MaxInt8}[r.Intn(2)]
+		}
+		return int8(r.Intn(256) - 128)
+	}
+	s, m := &menge.IntervalInt8Set{}, make(menge.Set[int8])
+	for i := 0; i < 3000; i++ {
+		lo, hi := random(), random()
+		if lo > hi && r.Intn(4) != 0 {
+			lo, hi = hi, lo
+		}
+		if hi-lo > 20 && r.Intn(2) == 0 {
+			hi = lo + 20
+		}
+		add := r.Intn(3) != 0
+		if add {
+			s.AddRange(lo, hi)
+		} else {
+			s.RemoveRange(lo, hi)
+		}
+		for e := int(lo); e <= int(hi); e++ {
+			if add {
+				m.Add(int8(e))
+			} else {
+				m.Remove(int8(e))
+			}
+		}
+		checkRanges(t, s, m)
+		if e := random(); s.Has(e) != m.Has(e) {
+			t.Fatalf("Has(%d) got: %v", e, s.Has(e))
+		}
+		if lo <= hi && s.HasRange(lo, hi) != m.IsSupersetOf(rangeSet(lo, hi)) {
+			t.Fatalf("HasRange(%d, %d) got: %v", lo, hi, s.HasRange(lo, hi))
+		}
+		if s.Size() != m.Size() {
+			t.Fatalf("Size got: %d, want: %d", s.Size(), m.Size())
+		}
+	}
+}
+
+// rangeSet returns a set of the elements from lo to hi, inclusive.
+func rangeSet(lo, hi int8) menge.Set[int8] {
+	m := make(menge.Set[int8])
+	for e := int(lo); e <= int(hi); e++ {
+		m.Add(int8(e))
+	}
+	return m
+}
+
+// TestIntervalSet compares the results of IntervalSet with those of Set.
+func TestIntervalSet(t *testing.T) {
+	sets := [][]menge.Interval[int8]{
+		{},
+		{{-128, 127}},
+		{{-128, -100}, {0, 0}, {100, 127}},
+		{{-101, -1}, {1, 99}},
+		{{-5, 5}, {50, 60}},
+	}
+	for _, a := range sets {
+		for _, b := range sets {
+			s, u := menge.NewIntervalSetFromRanges(a...), menge.NewIntervalSetFromRanges(b...)
+			ms, mu := s.ToSet(), u.ToSet()
+			if got, want := s.Equals(u), ms.Equals(mu); got != want {
+				t.Errorf("%v Equals %v got: %v", s, u, got)
+			}
+			for _, op := range []struct {
+				name string
+				f    func(s, u *menge.IntervalInt8Set) *menge.IntervalInt8Set
+				g    func(s, u menge.Set[int8]) menge.Set[int8]
+			}{
+				{"Union", (*menge.IntervalInt8Set).Union, menge.Set[int8].Union},
+				{"Intersection", (*menge.IntervalInt8Set).Intersection, menge.Set[int8].Intersection},
+				{"Difference", (*menge.IntervalInt8Set).Difference, menge.Set[int8].Difference},
+				{"SymmetricDifference", (*menge.IntervalInt8Set).SymmetricDifference, menge.Set[int8].SymmetricDifference},
+			} {
+				checkRanges(t, op.f(s, u), op.g(ms, mu))
+			}
+			for _, op := range []struct {
+				name string
+				f    func(s, u *menge.IntervalInt8Set)
+				g    func(s, u menge.Set[int8])
+			}{
+				{"UnionWith", (*menge.IntervalInt8Set).UnionWith, menge.Set[int8].UnionWith},
+				{"IntersectWith", (*menge.IntervalInt8Set).IntersectWith, menge.Set[int8].IntersectWith},
+				{"DifferenceWith", (*menge.IntervalInt8Set).DifferenceWith, menge.Set[int8].DifferenceWith},
+				{"SymmetricDifferenceWith", (*menge.IntervalInt8Set).SymmetricDifferenceWith, menge.Set[int8].SymmetricDifferenceWith},
+			} {
+				got, want := s.Clone(), ms.Clone()
+				op.f(got, u)
+				op.g(want, mu)
+				checkRanges(t, got, want)
+			}
+			for _, op := range []struct {
+				name string
+				f    func(s, u *menge.IntervalInt8Set) bool
+				g    func(s, u menge.Set[int8]) bool
+			}{
+				{"IsSubsetOf", (*menge.IntervalInt8Set).IsSubsetOf, menge.Set[int8].IsSubsetOf},
+				{"IsProperSubsetOf", (*menge.IntervalInt8Set).IsProperSubsetOf, menge.Set[int8].IsProperSubsetOf},
+				{"IsSupersetOf", (*menge.IntervalInt8Set).IsSupersetOf, menge.Set[int8].IsSupersetOf},
+				{"IsProperSupersetOf", (*menge.IntervalInt8Set).IsProperSupersetOf, menge.Set[int8].IsProperSupersetOf},
+				{"IsDisjointFrom", (*menge.IntervalInt8Set).IsDisjointFrom, menge.Set[int8].IsDisjointFrom},
+			} {
+				if got, want := op.f(s, u), op.g(ms, mu); got != want {
+					t.Errorf("%v %s %v got: %v", s, op.name, u, got)
+				}
+			}
+			if s.IntersectionSize(u) != ms.IntersectionSize(mu) || s.UnionSize(u) != ms.UnionSize(mu) || s.DifferenceSize(u) != ms.DifferenceSize(mu) {
+				t.Errorf("%v sizes %v got: %d %d %d", s, u, s.IntersectionSize(u), s.UnionSize(u), s.DifferenceSize(u))
+			}
+			if s.Jaccard(u) != ms.Jaccard(mu) || s.SorensenDice(u) != ms.SorensenDice(mu) || s.OverlapCoefficient(u) != ms.OverlapCoefficient(mu) || s.CosineSimilarity(u) != ms.CosineSimilarity(mu) {
+				t.Errorf("%v similarities %v got: %v %v %v %v", s, u, s.Jaccard(u), s.SorensenDice(u), s.OverlapCoefficient(u), s.CosineSimilarity(u))
+			}
+		}
+		s := menge.NewIntervalSetFromRanges(a...)
+		ms := s.ToSet()
+		if !reflect.DeepEqual(s.AsSortedSliceDesc(), ms.AsSortedSliceDesc()) || !reflect.DeepEqual(s.AppendTo([]int8{0}), append([]int8{0}, ms.AsSortedSlice()...)) {
+			t.Errorf("%v slices got: %v", s, s.AsSortedSliceDesc())
+		}
+		if u := menge.NewIntervalSetFromSet(ms); !u.Equals(s) {
+			t.Errorf("%v NewIntervalSetFromSet got: %v", s, u)
+		}
+		odd := func(e int8) bool { return e%2 != 0 }
+		checkRanges(t, s.Filter(odd), ms.Filter(odd))
+		in, out := s.Partition(odd)
+		mi, mo := ms.Partition(odd)
+		checkRanges(t, in, mi)
+		checkRanges(t, out, mo)
+		r, mr := s.Clone(), ms.Clone()
+		r.RemoveIf(odd)
+		mr.RemoveIf(odd)
+		checkRanges(t, r, mr)
+		r, mr = s.Clone(), ms.Clone()
+		r.Retain(odd)
+		mr.Retain(odd)
+		checkRanges(t, r, mr)
+		if s.Any(odd) != ms.Any(odd) || s.Every(odd) != ms.Every(odd) || s.None(odd) != ms.None(odd) || s.Count(odd) != ms.Count(odd) {
+			t.Errorf("%v predicates got: %v %v %v %v", s, s.Any(odd), s.Every(odd), s.None(odd), s.Count(odd))
+		}
+		for _, c := range [][2]int8{{-128, 127}, {-10, 10}, {60, 110}, {5, 4}} {
+			got := s.Complement(c[0], c[1])
+			want := rangeSet(c[0], c[1]).Difference(ms)
+			if c[0] > c[1] {
+				want = menge.Set[int8]{}
+			}
+			checkRanges(t, got, want)
+		}
+	}
+}
+
+func TestIntervalSet_Bounds(t *testing.T) {
+	s := menge.NewIntervalSetFromRanges(menge.Interval[int64]{Lo: math.MinInt64, Hi: math.MaxInt64})
+	if s.Size() != math.MaxInt || !s.Has(0) || !s.Has(math.MaxInt64) {
+		t.Errorf("full range got: %v, size: %d", s, s.Size())
+	}
+	s.Remove(0)
+	if got := fmt.Sprint(s.Ranges()); got != "[{-9223372036854775808 -1} {1 9223372036854775807}]" {
+		t.Errorf("Remove got: %s", got)
+	}
+	if c := s.Complement(math.MinInt64, math.MaxInt64); !c.Equals(menge.NewIntervalInt64Set(0)) {
+		t.Errorf("Complement got: %v", c)
+	}
+	u := menge.NewIntervalUInt8Set()
+	u.AddRange(250, 255)
+	var got []uint8
+	u.Ascend(func(e uint8) bool {
+		got = append(got, e)
+		return true
+	})
+	if !reflect.DeepEqual(got, []uint8{250, 251, 252, 253, 254, 255}) {
+		t.Errorf("Ascend got: %v", got)
+	}
+	if min, _ := u.Min(); min != 250 {
+		t.Errorf("Min got: %d", min)
+	}
+	if max, ok := u.Max(); max != 255 || !ok {
+		t.Errorf("Max got: %d", max)
+	}
+	if _, ok := menge.NewIntervalUInt8Set().Min(); ok {
+		t.Error("Min of empty set got: true")
+	}
+	var large menge.IntervalUInt32Set
+	large.AddRange(1, 1<<17)
+	if a, d := large.AsSlice(), large.AsSortedSliceDesc(); len(a) != 1<<17 || a[len(a)-1] != 1<<17 || len(d) != 1<<17 || d[0] != 1<<17 || len(large.ToSet()) != 1<<17 {
+		t.Errorf("conversions of %v got sizes: %d, %d", &large, len(a), len(d))
+	}
+}
+
+// TestIntervalSet_Ports is the motivating example of IntervalSet.
+func TestIntervalSet_Ports(t *testing.T) {
+	var ports menge.IntervalUInt16Set
+	ports.AddRange(1024, 65535)
+	ports.Remove(8080)
+	if got := ports.String(); got != "{1024..8079 8081..65535}" {
+		t.Errorf("String got: %s", got)
+	}
+	if ports.Size() != 64511 || ports.Has(8080) || !ports.Has(8081) || ports.Has(80) {
+		t.Errorf("got: %v", &ports)
+	}
+	set := menge.UInt16Set(ports.ToSet())
+	if set.Size() != 64511 || !menge.NewIntervalSetFromSet(set).Equals(&ports) {
+		t.Errorf("conversion got size: %d", set.Size())
+	}
+}
+
+func TestIntervalSet_Encoding(t *testing.T) {
+	s := menge.NewIntervalIntSet(1, 2, 3, 5, -1)
+	if got := fmt.Sprintf("%v %+v %3d", s, s, s); got != "{-1 1..3 5} {-1 1..3 5} (size 5) { -1   1..  3   5}" {
+		t.Errorf("Format got: %s", got)
+	}
+	if got := fmt.Sprintf("%#v", s); got != "menge.NewIntervalSetFromRanges[int](menge.Interval[int]{Lo:-1, Hi:-1}, menge.Interval[int]{Lo:1, Hi:3}, menge.Interval[int]{Lo:5, Hi:5})" {
+		t.Errorf("Format got: %s", got)
+	}
+	data, err := json.Marshal(s)
+	if err != nil || string(data) != `[[-1,-1],[1,3],[5,5]]` {
+		t.Errorf("MarshalJSON got: %s %v", data, err)
+	}
+	u := menge.NewIntervalIntSet(9)
+	if err := json.Unmarshal([]byte(`[[4,6],[1,2],[3,3],[9,8]]`), u); err != nil || u.String() != "{1..6}" {
+		t.Errorf("UnmarshalJSON got: %v %v", u, err)
+	}
+	v := menge.NewIntervalInt8Set()
+	if err := json.Unmarshal([]byte(`[[1,200]]`), v); err == nil {
+		t.Errorf("UnmarshalJSON of out of range element got: %v", v)
+	}
+}
@@ -0,0 +1,291 @@
+//go:build go1.23
+
+package menge
+
+import "iter"
+
+// All returns an iterator over the elements of the set,
+// with no specific order of the elements.
+func (s Set[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := range s {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// Sorted returns an iterator over the elements of the set,
+// in their natural order, as used by String.
+// The elements are sorted when the iteration starts.
+func (s Set[T]) Sorted() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		a := s.AsSortedSlice()
+		for _, e := range a {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// Collect returns a new Set containing the elements of seq.
+func Collect[T comparable](seq iter.Seq[T]) Set[T] {
+	s := make(Set[T])
+	for e := range seq {
+		s[e] = struct{}{}
+	}
+	return s
+}
+
+// All returns an iterator over the elements of a snapshot of the set,
+// taken when the iteration starts, with no specific order of the elements.
+func (s *SyncSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.Snapshot().All()(yield)
+	}
+}
+
+// Sorted returns an iterator over the elements of a snapshot of the set,
+// taken when the iteration starts, in their natural order.
+func (s *SyncSet[T]) Sorted() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.Snapshot().Sorted()(yield)
+	}
+}
+
+// All returns an iterator over the elements of a snapshot of the set,
+// taken when the iteration starts, with no specific order of the elements.
+func (s *ShardedSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.Snapshot().All()(yield)
+	}
+}
+
+// Sorted returns an iterator over the elements of a snapshot of the set,
+// taken when the iteration starts, in their natural order.
+func (s *ShardedSet[T]) Sorted() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.Snapshot().Sorted()(yield)
+	}
+}
+
+// All returns an iterator over the elements of the set in ascending order.
+func (s *OrderedSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.root.ascend(yield)
+	}
+}
+
+// Sorted returns an iterator over the elements of the set in ascending order.
+// It is the same as All.
+func (s *OrderedSet[T]) Sorted() iter.Seq[T] {
+	return s.All()
+}
+
+// Backward returns an iterator over the elements of the set in descending
+// order.
+func (s *OrderedSet[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.root.descend(yield)
+	}
+}
+
+// Between returns an iterator over the elements e of the set such that
+// lo <= e < hi, in ascending order.
+func (s *OrderedSet[T]) Between(lo, hi T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.AscendRange(lo, hi, yield)
+	}
+}
+
+// All returns an iterator over the elements of the set in ascending order.
+func (s *BitSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.ascend(yield)
+	}
+}
+
+// Sorted returns an iterator over the elements of the set in ascending order.
+// It is the same as All.
+func (s *BitSet[T]) Sorted() iter.Seq[T] {
+	return s.All()
+}
+
+// All returns an iterator over the elements of the set in ascending order.
+func (s *RoaringUInt32Set) All() iter.Seq[uint32] {
+	return func(yield func(uint32) bool) {
+		s.ascend(yield)
+	}
+}
+
+// Sorted returns an iterator over the elements of the set in ascending order.
+// It is the same as All.
+func (s *RoaringUInt32Set) Sorted() iter.Seq[uint32] {
+	return s.All()
+}
+
+// All returns an iterator over the elements of the set in ascending order.
+func (s *RoaringUInt64Set) All() iter.Seq[uint64] {
+	return func(yield func(uint64) bool) {
+		s.ascend(yield)
+	}
+}
+
+// Sorted returns an iterator over the elements of the set in ascending order.
+// It is the same as All.
+func (s *RoaringUInt64Set) Sorted() iter.Seq[uint64] {
+	return s.All()
+}
+
+// All returns an iterator over the elements of the set in ascending order.
+func (s *IntervalSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.Ascend(yield)
+	}
+}
+
+// Sorted returns an iterator over the elements of the set in ascending order.
+// It is the same as All.
+func (s *IntervalSet[T]) Sorted() iter.Seq[T] {
+	return s.All()
+}
+
+// Backward returns an iterator over the elements of the set in descending
+// order.
+func (s *IntervalSet[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.Descend(yield)
+	}
+}
+
+// AllRanges returns an iterator over the lower and upper bounds of the
+// intervals of the set in ascending order.
+func (s *IntervalSet[T]) AllRanges() iter.Seq2[T, T] {
+	return func(yield func(T, T) bool) {
+		s.AscendRanges(yield)
+	}
+}
+
+// All returns an iterator over the elements of the set, with no specific
+// order of the elements.
+func (s PersistentSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.root.each(yield)
+	}
+}
+
+// Sorted returns an iterator over the elements of the set in ascending order.
+// See LessFunc for the order.
+func (s PersistentSet[T]) Sorted() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, e := range s.AsSortedSlice() {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the elements of the set, in the order of the
+// All method of S.
+func (f Frozen[S, T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		f.s.Every(yield)
+	}
+}
+
+// Sorted returns an iterator over the elements of the set in ascending order.
+// See LessFunc for the order.
+func (f Frozen[S, T]) Sorted() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, e := range f.s.AsSortedSlice() {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the elements of the bag and their numbers of
+// occurrences, with no specific order of the elements.
+func (b Bag[T]) All() iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		for e, n := range b {
+			if !yield(e, n) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the elements of the set in ascending order.
+func (s *TrieStringSet) All() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		s.Ascend(yield)
+	}
+}
+
+// Sorted returns an iterator over the elements of the set in ascending order.
+// It is the same as All.
+func (s *TrieStringSet) Sorted() iter.Seq[string] {
+	return s.All()
+}
+
+// Backward returns an iterator over the elements of the set in descending
+// order.
+func (s *TrieStringSet) Backward() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		s.Descend(yield)
+	}
+}
+
+// WithPrefix returns an iterator over the elements of the set that start
+// with prefix, in ascending order.
+func (s *TrieStringSet) WithPrefix(prefix string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		s.AscendPrefix(prefix, yield)
+	}
+}
+
+// WithSuffix returns an iterator over the elements of the set that end with
+// suffix, in ascending order. See AscendSuffix.
+func (s *TrieStringSet) WithSuffix(suffix string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		s.AscendSuffix(suffix, yield)
+	}
+}
+
+// All returns an iterator over the elements of the set, with no specific
+// order of the elements.
+func (s *FoldedStringSet) All() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		s.Every(yield)
+	}
+}
+
+// Sorted returns an iterator over the elements of the set in ascending order
+// of their spellings.
+func (s *FoldedStringSet) Sorted() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for _, e := range s.AsSortedSlice() {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the elements of the set in ascending order.
+func (s *ApproxSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.Every(yield)
+	}
+}
+
+// Sorted returns an iterator over the elements of the set in ascending order.
+// It is the same as All.
+func (s *ApproxSet[T]) Sorted() iter.Seq[T] {
+	return s.All()
+}
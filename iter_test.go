@@ -0,0 +1,261 @@
+//go:build go1.23
+
+package menge_test
+
+import (
+	"iter"
+	"maps"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/soroushj/menge"
+)
+
+// iterSet is the method set of the set types related to iteration.
+type iterSet[T comparable] interface {
+	~map[T]struct{}
+	All() iter.Seq[T]
+	Sorted() iter.Seq[T]
+	Size() int
+}
+
+func TestIterators(t *testing.T) {
+	t.Run("Complex128Set", iterSuite(menge.NewComplex128Set, menge.CollectComplex128Set, 1, 2, 3))
+	t.Run("Complex64Set", iterSuite(menge.NewComplex64Set, menge.CollectComplex64Set, 1, 2, 3))
+	t.Run("Float32Set", iterSuite(menge.NewFloat32Set, menge.CollectFloat32Set, 1, 2, 3))
+	t.Run("Float64Set", iterSuite(menge.NewFloat64Set, menge.CollectFloat64Set, 1, 2, 3))
+	t.Run("IntSet", iterSuite(menge.NewIntSet, menge.CollectIntSet, 1, 2, 3))
+	t.Run("Int16Set", iterSuite(menge.NewInt16Set, menge.CollectInt16Set, 1, 2, 3))
+	t.Run("Int32Set", iterSuite(menge.NewInt32Set, menge.CollectInt32Set, 1, 2, 3))
+	t.Run("Int64Set", iterSuite(menge.NewInt64Set, menge.CollectInt64Set, 1, 2, 3))
+	t.Run("Int8Set", iterSuite(menge.NewInt8Set, menge.CollectInt8Set, 1, 2, 3))
+	t.Run("StringSet", iterSuite(menge.NewStringSet, menge.CollectStringSet, "1", "2", "3"))
+	t.Run("UIntSet", iterSuite(menge.NewUIntSet, menge.CollectUIntSet, 1, 2, 3))
+	t.Run("UInt16Set", iterSuite(menge.NewUInt16Set, menge.CollectUInt16Set, 1, 2, 3))
+	t.Run("UInt32Set", iterSuite(menge.NewUInt32Set, menge.CollectUInt32Set, 1, 2, 3))
+	t.Run("UInt64Set", iterSuite(menge.NewUInt64Set, menge.CollectUInt64Set, 1, 2, 3))
+	t.Run("UInt8Set", iterSuite(menge.NewUInt8Set, menge.CollectUInt8Set, 1, 2, 3))
+	t.Run("UIntPtrSet", iterSuite(menge.NewUIntPtrSet, menge.CollectUIntPtrSet, 1, 2, 3))
+	t.Run("Set[int]", iterSuite(menge.NewSet[int], menge.Collect[int], 1, 2, 3))
+	t.Run("Set[point]", iterSuite(menge.NewSet[point], menge.Collect[point], point{1, 1}, point{2, 2}, point{3, 3}))
+}
+
+func iterSuite[T comparable, S iterSet[T]](newSet func(...T) S, collect func(iter.Seq[T]) S, a, b, c T) func(*testing.T) {
+	return func(t *testing.T) {
+		s := newSet(c, a, b)
+		if got := collect(s.All()); len(got) != 3 || !menge.Set[T](got).Equals(menge.Set[T](s)) {
+			t.Errorf("All got: %v", got)
+		}
+		if got := slices.Collect(s.Sorted()); !slices.Equal(got, []T{a, b, c}) {
+			t.Errorf("Sorted got: %v", got)
+		}
+		for name, seq := range map[string]iter.Seq[T]{"All": s.All(), "Sorted": s.Sorted()} {
+			n := 0
+			for range seq {
+				n++
+				if n == 2 {
+					break
+				}
+			}
+			if n != 2 {
+				t.Errorf("%s did not stop early: %d", name, n)
+			}
+		}
+		if got := collect(slices.Values([]T{a, b, a})); !menge.Set[T](got).Equals(menge.NewSet(a, b)) {
+			t.Errorf("Collect got: %v", got)
+		}
+		if got := collect(newSet().All()); got.Size() != 0 {
+			t.Errorf("Collect of empty got: %v", got)
+		}
+	}
+}
+
+func TestCollectFloat64Set_NaN(t *testing.T) {
+	got := menge.CollectFloat64Set(slices.Values([]float64{1, math.NaN()}))
+	if !got.Equals(menge.NewFloat64Set(1)) {
+		t.Errorf("got: %v", got)
+	}
+}
+
+func TestSyncSet_Iterators(t *testing.T) {
+	s := menge.NewSyncIntSet(3, 1, 2)
+	if got := slices.Collect(s.Sorted()); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Sorted got: %v", got)
+	}
+	for e := range s.All() {
+		s.Remove(e)
+	}
+	if !s.IsEmpty() {
+		t.Errorf("All got: %v", s)
+	}
+	u := menge.NewShardedIntSet(3, 1, 2)
+	if got := slices.Collect(u.Sorted()); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Sorted got: %v", got)
+	}
+	for e := range u.All() {
+		u.Remove(e)
+	}
+	if !u.IsEmpty() {
+		t.Errorf("All got: %v", u)
+	}
+}
+
+func TestOrderedSet_Iterators(t *testing.T) {
+	s := menge.NewOrderedIntSet(3, 1, 4, 2)
+	if got := slices.Collect(s.All()); !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Errorf("All got: %v", got)
+	}
+	if got := slices.Collect(s.Backward()); !slices.Equal(got, []int{4, 3, 2, 1}) {
+		t.Errorf("Backward got: %v", got)
+	}
+	if got := slices.Collect(s.Between(2, 4)); !slices.Equal(got, []int{2, 3}) {
+		t.Errorf("Between got: %v", got)
+	}
+	for e := range s.Sorted() {
+		if e == 2 {
+			break
+		}
+	}
+}
+
+func TestBitSet_Iterators(t *testing.T) {
+	s := menge.NewBitInt16Set(300, -300, 0)
+	if got := slices.Collect(s.All()); !slices.Equal(got, []int16{-300, 0, 300}) {
+		t.Errorf("All got: %v", got)
+	}
+	for e := range s.Sorted() {
+		if e == 0 {
+			break
+		}
+	}
+}
+
+func TestRoaringSet_Iterators(t *testing.T) {
+	s := menge.NewRoaringUInt32Set(1<<20, 2, 1)
+	if got := slices.Collect(s.All()); !slices.Equal(got, []uint32{1, 2, 1 << 20}) {
+		t.Errorf("All got: %v", got)
+	}
+	for e := range s.Sorted() {
+		if e == 2 {
+			break
+		}
+	}
+	u := menge.NewRoaringUInt64Set(1<<40, 1)
+	if got := slices.Collect(u.Sorted()); !slices.Equal(got, []uint64{1, 1 << 40}) {
+		t.Errorf("Sorted got: %v", got)
+	}
+}
+
+func TestIntervalSet_Iterators(t *testing.T) {
+	s := menge.NewIntervalSetFromRanges(menge.Interval[uint8]{Lo: 253, Hi: 255}, menge.Interval[uint8]{Lo: 1, Hi: 1})
+	if got := slices.Collect(s.All()); !slices.Equal(got, []uint8{1, 253, 254, 255}) {
+		t.Errorf("All got: %v", got)
+	}
+	if got := slices.Collect(s.Backward()); !slices.Equal(got, []uint8{255, 254, 253, 1}) {
+		t.Errorf("Backward got: %v", got)
+	}
+	var got []uint8
+	for lo, hi := range s.AllRanges() {
+		got = append(got, lo, hi)
+	}
+	if !slices.Equal(got, []uint8{1, 1, 253, 255}) {
+		t.Errorf("AllRanges got: %v", got)
+	}
+	for e := range s.Sorted() {
+		if e == 253 {
+			break
+		}
+	}
+}
+
+func TestPersistentSet_Iterators(t *testing.T) {
+	s := menge.NewPersistentIntSet(3, 1, 2)
+	if got := slices.Sorted(s.All()); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("All got: %v", got)
+	}
+	if got := slices.Collect(s.Sorted()); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Sorted got: %v", got)
+	}
+	for e := range s.All() {
+		if e == 2 {
+			break
+		}
+	}
+}
+
+func TestFrozen_Iterators(t *testing.T) {
+	f := menge.NewIntSet(3, 1, 2).Freeze()
+	if got := slices.Sorted(f.All()); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("All got: %v", got)
+	}
+	if got := slices.Collect(f.Sorted()); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Sorted got: %v", got)
+	}
+	for e := range f.All() {
+		if e == 2 {
+			break
+		}
+	}
+}
+
+func TestBag_Iterators(t *testing.T) {
+	b := menge.NewStringBag("a", "b", "a")
+	got := map[string]int{}
+	for e, n := range b.All() {
+		got[e] = n
+	}
+	if !maps.Equal(got, map[string]int{"a": 2, "b": 1}) {
+		t.Errorf("All got: %v", got)
+	}
+	for range b.All() {
+		break
+	}
+}
+
+func TestTrieStringSet_Iterators(t *testing.T) {
+	s := menge.NewTrieStringSet("ab", "a", "b", "abc")
+	if got := slices.Collect(s.All()); !slices.Equal(got, []string{"a", "ab", "abc", "b"}) {
+		t.Errorf("All got: %v", got)
+	}
+	if got := slices.Collect(s.Backward()); !slices.Equal(got, []string{"b", "abc", "ab", "a"}) {
+		t.Errorf("Backward got: %v", got)
+	}
+	if got := slices.Collect(s.WithPrefix("ab")); !slices.Equal(got, []string{"ab", "abc"}) {
+		t.Errorf("WithPrefix got: %v", got)
+	}
+	if got := slices.Collect(s.WithSuffix("b")); !slices.Equal(got, []string{"ab", "b"}) {
+		t.Errorf("WithSuffix got: %v", got)
+	}
+	for e := range s.Sorted() {
+		if e == "ab" {
+			break
+		}
+	}
+}
+
+func TestFoldedStringSet_Iterators(t *testing.T) {
+	s := menge.NewCaseInsensitiveStringSet("b", "A", "a")
+	if got := slices.Sorted(s.All()); !slices.Equal(got, []string{"A", "b"}) {
+		t.Errorf("All got: %v", got)
+	}
+	if got := slices.Collect(s.Sorted()); !slices.Equal(got, []string{"A", "b"}) {
+		t.Errorf("Sorted got: %v", got)
+	}
+	for range s.All() {
+		break
+	}
+}
+
+func TestApproxSet_Iterators(t *testing.T) {
+	s := menge.NewApproxFloat64Set(menge.AbsTolerance(0.01), 2, 1, 1.001)
+	if got := slices.Collect(s.All()); !slices.Equal(got, []float64{1, 2}) {
+		t.Errorf("All got: %v", got)
+	}
+	if got := slices.Collect(s.Sorted()); !slices.Equal(got, []float64{1, 2}) {
+		t.Errorf("Sorted got: %v", got)
+	}
+	for range s.All() {
+		break
+	}
+}
@@ -0,0 +1,208 @@
+package menge
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// MarshalJSON implements the json.Marshaler interface.
+// The set is encoded as a JSON array of its elements in their natural order.
+// Infinite and NaN floats are encoded as the strings "+Inf", "-Inf", and
+// "NaN". Complex numbers are encoded as [real, imaginary] pairs of floats.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	return marshalElems(s.AsSortedSlice())
+}
+
+// marshalElems returns the JSON encoding of a set with the ordered elements a.
+func marshalElems[T comparable](a []T) ([]byte, error) {
+	enc := elemEncoder[T]()
+	b := &bytes.Buffer{}
+	b.WriteByte('[')
+	for i, e := range a {
+		if i != 0 {
+			b.WriteByte(',')
+		}
+		if err := enc(b, e); err != nil {
+			return nil, err
+		}
+	}
+	b.WriteByte(']')
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts the encoding produced by MarshalJSON, in any order and with
+// duplicates, and replaces the elements of the set with the decoded ones.
+// As with other unmarshalers, the JSON null value is a no-op.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	elems, err := unmarshalElems[T](data)
+	if err != nil || elems == nil {
+		return err
+	}
+	if *s == nil {
+		*s = make(Set[T], len(elems))
+	} else {
+		s.Empty()
+	}
+	s.Add(elems...)
+	return nil
+}
+
+// unmarshalElems decodes the JSON encoding of a set, as produced by
+// marshalElems, into its elements in their order in the array.
+// It returns nil for the JSON null value.
+func unmarshalElems[T comparable](data []byte) ([]T, error) {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return nil, fmt.Errorf("menge: %w", err)
+	}
+	if raws == nil {
+		return nil, nil
+	}
+	dec := elemDecoder[T]()
+	elems := make([]T, len(raws))
+	for i, raw := range raws {
+		if err := dec(raw, &elems[i]); err != nil {
+			return nil, fmt.Errorf("menge: element %d: %w", i, err)
+		}
+	}
+	return elems, nil
+}
+
+// elemEncoder returns a function that writes the JSON encoding of an element.
+func elemEncoder[T comparable]() func(b *bytes.Buffer, e T) error {
+	var zero T
+	switch reflect.TypeOf(&zero).Elem().Kind() {
+	case reflect.Float32, reflect.Float64:
+		bits := reflect.TypeOf(zero).Bits()
+		return func(b *bytes.Buffer, e T) error {
+			return encodeFloat(b, reflect.ValueOf(e).Float(), bits)
+		}
+	case reflect.Complex64, reflect.Complex128:
+		bits := reflect.TypeOf(zero).Bits() / 2
+		return func(b *bytes.Buffer, e T) error {
+			c := reflect.ValueOf(e).Complex()
+			b.WriteByte('[')
+			if err := encodeFloat(b, real(c), bits); err != nil {
+				return err
+			}
+			b.WriteByte(',')
+			if err := encodeFloat(b, imag(c), bits); err != nil {
+				return err
+			}
+			b.WriteByte(']')
+			return nil
+		}
+	}
+	return func(b *bytes.Buffer, e T) error {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		b.Write(data)
+		return nil
+	}
+}
+
+// elemDecoder returns a function that decodes the JSON encoding of an element.
+func elemDecoder[T comparable]() func(data []byte, e *T) error {
+	var zero T
+	switch reflect.TypeOf(&zero).Elem().Kind() {
+	case reflect.Float32, reflect.Float64:
+		return func(data []byte, e *T) error {
+			v := reflect.ValueOf(e).Elem()
+			f, err := decodeFloat(data)
+			if err != nil {
+				return err
+			}
+			if v.OverflowFloat(f) {
+				return fmt.Errorf("%v overflows %v", f, v.Type())
+			}
+			v.SetFloat(f)
+			return nil
+		}
+	case reflect.Complex64, reflect.Complex128:
+		return func(data []byte, e *T) error {
+			v := reflect.ValueOf(e).Elem()
+			var parts []json.RawMessage
+			if err := json.Unmarshal(data, &parts); err != nil {
+				return err
+			}
+			if len(parts) != 2 {
+				return errors.New("complex number must be a [real, imaginary] pair")
+			}
+			re, err := decodeFloat(parts[0])
+			if err != nil {
+				return err
+			}
+			im, err := decodeFloat(parts[1])
+			if err != nil {
+				return err
+			}
+			c := complex(re, im)
+			if v.OverflowComplex(c) {
+				return fmt.Errorf("%v overflows %v", c, v.Type())
+			}
+			v.SetComplex(c)
+			return nil
+		}
+	}
+	return func(data []byte, e *T) error {
+		return json.Unmarshal(data, e)
+	}
+}
+
+// encodeFloat writes the JSON encoding of f, which is a float of the given
+// bit size.
+func encodeFloat(b *bytes.Buffer, f float64, bits int) error {
+	switch {
+	case math.IsNaN(f):
+		b.WriteString(`"NaN"`)
+		return nil
+	case math.IsInf(f, 1):
+		b.WriteString(`"+Inf"`)
+		return nil
+	case math.IsInf(f, -1):
+		b.WriteString(`"-Inf"`)
+		return nil
+	}
+	var data []byte
+	var err error
+	if bits == 32 {
+		data, err = json.Marshal(float32(f))
+	} else {
+		data, err = json.Marshal(f)
+	}
+	if err != nil {
+		return err
+	}
+	b.Write(data)
+	return nil
+}
+
+// decodeFloat decodes a JSON number or one of the strings "NaN", "+Inf",
+// "Inf", and "-Inf".
+func decodeFloat(data []byte) (float64, error) {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		switch s {
+		case "NaN":
+			return math.NaN(), nil
+		case "+Inf", "Inf":
+			return math.Inf(1), nil
+		case "-Inf":
+			return math.Inf(-1), nil
+		}
+		return 0, fmt.Errorf("invalid float %s", strconv.Quote(s))
+	}
+	var f float64
+	if err := json.Unmarshal(data, &f); err != nil {
+		return 0, err
+	}
+	return f, nil
+}
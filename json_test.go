@@ -0,0 +1,162 @@
+package menge_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/soroushj/menge"
+)
+
+func TestSets_JSON(t *testing.T) {
+	t.Run("Complex128Set", jsonSuite(menge.NewComplex128Set, 1, 2, 3, "[[1,0],[2,0],[3,0]]"))
+	t.Run("Complex64Set", jsonSuite(menge.NewComplex64Set, 1, 2, 3, "[[1,0],[2,0],[3,0]]"))
+	t.Run("Float32Set", jsonSuite(menge.NewFloat32Set, 1, 2, 3, "[1,2,3]"))
+	t.Run("Float64Set", jsonSuite(menge.NewFloat64Set, 1, 2, 3, "[1,2,3]"))
+	t.Run("IntSet", jsonSuite(menge.NewIntSet, 1, 2, 3, "[1,2,3]"))
+	t.Run("Int16Set", jsonSuite(menge.NewInt16Set, 1, 2, 3, "[1,2,3]"))
+	t.Run("Int32Set", jsonSuite(menge.NewInt32Set, 1, 2, 3, "[1,2,3]"))
+	t.Run("Int64Set", jsonSuite(menge.NewInt64Set, 1, 2, 3, "[1,2,3]"))
+	t.Run("Int8Set", jsonSuite(menge.NewInt8Set, 1, 2, 3, "[1,2,3]"))
+	t.Run("StringSet", jsonSuite(menge.NewStringSet, "1", "2", "3", `["1","2","3"]`))
+	t.Run("UIntSet", jsonSuite(menge.NewUIntSet, 1, 2, 3, "[1,2,3]"))
+	t.Run("UInt16Set", jsonSuite(menge.NewUInt16Set, 1, 2, 3, "[1,2,3]"))
+	t.Run("UInt32Set", jsonSuite(menge.NewUInt32Set, 1, 2, 3, "[1,2,3]"))
+	t.Run("UInt64Set", jsonSuite(menge.NewUInt64Set, 1, 2, 3, "[1,2,3]"))
+	t.Run("UInt8Set", jsonSuite(menge.NewUInt8Set, 1, 2, 3, "[1,2,3]"))
+	t.Run("UIntPtrSet", jsonSuite(menge.NewUIntPtrSet, 1, 2, 3, "[1,2,3]"))
+	t.Run("Set[point]", jsonSuite(menge.NewSet[point], point{1, 1}, point{2, 2}, point{3, 3}, "[{},{},{}]"))
+}
+
+// jsonSuite returns a test of the JSON encoding of the set type S.
+// The elements a, b, and c must be in ascending order and want must be the
+// encoding of a set containing them.
+func jsonSuite[T comparable, S set[T, S]](newSet func(...T) S, a, b, c T, want string) func(*testing.T) {
+	return func(t *testing.T) {
+		got, err := json.Marshal(newSet(c, a, b))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != want {
+			t.Errorf("marshal got: %s want: %s", got, want)
+		}
+		got, err = json.Marshal(newSet())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != "[]" {
+			t.Errorf("marshal empty got: %s", got)
+		}
+		if want == "[{},{},{}]" {
+			// The elements cannot be decoded back.
+			return
+		}
+		var s S
+		if err := json.Unmarshal([]byte(want), &s); err != nil {
+			t.Fatal(err)
+		}
+		if !s.Equals(newSet(a, b, c)) {
+			t.Errorf("unmarshal got: %v", s)
+		}
+		s = newSet(a)
+		if err := json.Unmarshal([]byte("null"), &s); err != nil {
+			t.Fatal(err)
+		}
+		if !s.Equals(newSet(a)) {
+			t.Errorf("unmarshal null got: %v", s)
+		}
+		if err := json.Unmarshal([]byte("[]"), &s); err != nil {
+			t.Fatal(err)
+		}
+		if !s.IsEmpty() {
+			t.Errorf("unmarshal empty got: %v", s)
+		}
+	}
+}
+
+func TestSet_MarshalJSON(t *testing.T) {
+	cases := []struct {
+		set  json.Marshaler
+		want string
+	}{
+		{menge.NewIntSet(10, -1, 2), "[-1,2,10]"},
+		{menge.NewStringSet("b", "a", "B"), `["B","a","b"]`},
+		{menge.NewFloat64Set(math.Inf(1), 0.5, math.Inf(-1), -2), `["-Inf",-2,0.5,"+Inf"]`},
+		{menge.NewFloat32Set(0.1, float32(math.Inf(1))), `[0.1,"+Inf"]`},
+		{menge.NewSet(math.NaN(), 1), `["NaN",1]`},
+		{menge.NewComplex128Set(complex(1, 2), complex(1, -1), complex(-1, 5)), "[[-1,5],[1,-1],[1,2]]"},
+		{menge.NewComplex64Set(complex(float32(math.Inf(1)), 0.1)), `[["+Inf",0.1]]`},
+		{menge.NewSet(complex(math.NaN(), 0)), `[["NaN",0]]`},
+	}
+	for _, c := range cases {
+		got, err := json.Marshal(c.set)
+		if err != nil {
+			t.Errorf("case: %v error: %v", c, err)
+			continue
+		}
+		if string(got) != c.want {
+			t.Errorf("case: %v got: %s", c, got)
+		}
+	}
+}
+
+func TestSet_UnmarshalJSON(t *testing.T) {
+	var i menge.IntSet
+	if err := json.Unmarshal([]byte("[3,1,3,2,1]"), &i); err != nil {
+		t.Fatal(err)
+	}
+	if !i.Equals(menge.NewIntSet(1, 2, 3)) {
+		t.Errorf("duplicates got: %v", i)
+	}
+	var f menge.Float64Set
+	if err := json.Unmarshal([]byte(`[1,"NaN","+Inf","Inf","-Inf",1e308]`), &f); err != nil {
+		t.Fatal(err)
+	}
+	if !f.Equals(menge.NewFloat64Set(1, math.Inf(1), math.Inf(-1), 1e308)) {
+		t.Errorf("floats got: %v", f)
+	}
+	var c menge.Complex64Set
+	if err := json.Unmarshal([]byte(`[[1,2],["-Inf",0]]`), &c); err != nil {
+		t.Fatal(err)
+	}
+	if !c.Equals(menge.NewComplex64Set(complex(1, 2), complex(float32(math.Inf(-1)), 0))) {
+		t.Errorf("complex got: %v", c)
+	}
+	var v struct {
+		IDs menge.UInt32Set `json:"ids"`
+	}
+	if err := json.Unmarshal([]byte(`{"ids":[7,5]}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if !v.IDs.Equals(menge.NewUInt32Set(5, 7)) {
+		t.Errorf("field got: %v", v.IDs)
+	}
+}
+
+func TestSet_UnmarshalJSON_Errors(t *testing.T) {
+	cases := []struct {
+		set  any
+		data string
+	}{
+		{&menge.IntSet{}, `{"1":{}}`},
+		{&menge.IntSet{}, `[1,"2"]`},
+		{&menge.IntSet{}, `[1.5]`},
+		{&menge.Int8Set{}, `[128]`},
+		{&menge.UIntSet{}, `[-1]`},
+		{&menge.StringSet{}, `["a",1]`},
+		{&menge.Float64Set{}, `["x"]`},
+		{&menge.Float64Set{}, `[true]`},
+		{&menge.Float32Set{}, `[1e300]`},
+		{&menge.Complex128Set{}, `[1]`},
+		{&menge.Complex128Set{}, `[[1]]`},
+		{&menge.Complex128Set{}, `[[1,2,3]]`},
+		{&menge.Complex128Set{}, `[[1,"i"]]`},
+		{&menge.Complex64Set{}, `[[1e300,0]]`},
+		{&menge.IntSet{}, `[1`},
+	}
+	for _, c := range cases {
+		if err := json.Unmarshal([]byte(c.data), c.set); err == nil {
+			t.Errorf("case: %v got no error", c)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package menge
+
+import "sort"
+
+// UnionAll returns the union of zero or more sets.
+// The result is allocated once, with room for all the elements of the sets.
+func UnionAll[S ~map[T]struct{}, T comparable](sets ...S) S {
+	n := 0
+	for _, s := range sets {
+		n += len(s)
+	}
+	r := make(S, n)
+	for _, s := range sets {
+		for e := range s {
+			r[e] = struct{}{}
+		}
+	}
+	return r
+}
+
+// IntersectionAll returns the intersection of zero or more sets.
+// The intersection of zero sets is the empty set.
+// The sets are intersected in ascending order of size, starting from a copy
+// of the smallest set, and the computation stops as soon as the intermediate
+// result is empty.
+func IntersectionAll[S ~map[T]struct{}, T comparable](sets ...S) S {
+	if len(sets) == 0 {
+		return make(S)
+	}
+	sorted := make([]S, len(sets))
+	copy(sorted, sets)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return len(sorted[i]) < len(sorted[j])
+	})
+	r := make(S, len(sorted[0]))
+	for e := range sorted[0] {
+		r[e] = struct{}{}
+	}
+	for _, s := range sorted[1:] {
+		if len(r) == 0 {
+			break
+		}
+		Set[T](r).IntersectWith(Set[T](s))
+	}
+	return r
+}
+
+// DifferenceAll returns the difference of s and zero or more sets,
+// i.e., the elements of s that are in none of the sets.
+// The computation stops as soon as the intermediate result is empty.
+func DifferenceAll[S ~map[T]struct{}, T comparable](s S, sets ...S) S {
+	r := make(S, len(s))
+	for e := range s {
+		r[e] = struct{}{}
+	}
+	for _, t := range sets {
+		if len(r) == 0 {
+			break
+		}
+		Set[T](r).DifferenceWith(Set[T](t))
+	}
+	return r
+}
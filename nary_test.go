@@ -0,0 +1,142 @@
+package menge_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/soroushj/menge"
+)
+
+func TestUnionInt(t *testing.T) {
+	cases := []struct {
+		args []menge.IntSet
+		want menge.IntSet
+	}{
+		{nil, menge.NewIntSet()},
+		{[]menge.IntSet{menge.NewIntSet(1)}, menge.NewIntSet(1)},
+		{[]menge.IntSet{menge.NewIntSet(1), menge.NewIntSet()}, menge.NewIntSet(1)},
+		{[]menge.IntSet{menge.NewIntSet(1, 2), menge.NewIntSet(2, 3), menge.NewIntSet(4)}, menge.NewIntSet(1, 2, 3, 4)},
+	}
+	for _, c := range cases {
+		got := menge.UnionInt(c.args...)
+		if !got.Equals(c.want) {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func TestIntersectionInt(t *testing.T) {
+	cases := []struct {
+		args []menge.IntSet
+		want menge.IntSet
+	}{
+		{nil, menge.NewIntSet()},
+		{[]menge.IntSet{menge.NewIntSet(1)}, menge.NewIntSet(1)},
+		{[]menge.IntSet{menge.NewIntSet(1), menge.NewIntSet()}, menge.NewIntSet()},
+		{[]menge.IntSet{menge.NewIntSet(1, 2, 3), menge.NewIntSet(2, 3), menge.NewIntSet(3, 4)}, menge.NewIntSet(3)},
+		{[]menge.IntSet{menge.NewIntSet(1, 2, 3), menge.NewIntSet(4), menge.NewIntSet(1, 4)}, menge.NewIntSet()},
+	}
+	for _, c := range cases {
+		got := menge.IntersectionInt(c.args...)
+		if !got.Equals(c.want) {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+	s := menge.NewIntSet(1, 2)
+	menge.IntersectionInt(s, menge.NewIntSet(1))
+	if !s.Equals(menge.NewIntSet(1, 2)) {
+		t.Errorf("argument modified: %v", s)
+	}
+}
+
+func TestDifferenceInt(t *testing.T) {
+	cases := []struct {
+		set  menge.IntSet
+		args []menge.IntSet
+		want menge.IntSet
+	}{
+		{menge.NewIntSet(), nil, menge.NewIntSet()},
+		{menge.NewIntSet(1, 2), nil, menge.NewIntSet(1, 2)},
+		{menge.NewIntSet(1, 2, 3), []menge.IntSet{menge.NewIntSet(1), menge.NewIntSet(3, 4)}, menge.NewIntSet(2)},
+		{menge.NewIntSet(1, 2), []menge.IntSet{menge.NewIntSet(1, 2), menge.NewIntSet(3)}, menge.NewIntSet()},
+	}
+	for _, c := range cases {
+		got := menge.DifferenceInt(c.set, c.args...)
+		if !got.Equals(c.want) {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+	s := menge.NewIntSet(1, 2)
+	menge.DifferenceInt(s, menge.NewIntSet(1))
+	if !s.Equals(menge.NewIntSet(1, 2)) {
+		t.Errorf("argument modified: %v", s)
+	}
+}
+
+func TestNAry_Set(t *testing.T) {
+	a, b := menge.NewSet("a", "b"), menge.NewSet("b", "c")
+	if got := menge.UnionAll(a, b); !got.Equals(menge.NewSet("a", "b", "c")) {
+		t.Errorf("UnionAll got: %v", got)
+	}
+	if got := menge.IntersectionAll(a, b); !got.Equals(menge.NewSet("b")) {
+		t.Errorf("IntersectionAll got: %v", got)
+	}
+	if got := menge.DifferenceAll(a, b); !got.Equals(menge.NewSet("a")) {
+		t.Errorf("DifferenceAll got: %v", got)
+	}
+	if got := menge.UnionString(menge.NewStringSet("x"), menge.NewStringSet("y")); !got.Equals(menge.NewStringSet("x", "y")) {
+		t.Errorf("UnionString got: %v", got)
+	}
+}
+
+// benchmarkSets returns k sets of n elements each, overlapping by half.
+func benchmarkSets(k, n int) []menge.IntSet {
+	sets := make([]menge.IntSet, k)
+	for i := range sets {
+		sets[i] = menge.NewIntSet()
+		for j := 0; j < n; j++ {
+			sets[i].Add(i*n/2 + j)
+		}
+	}
+	return sets
+}
+
+func BenchmarkUnionInt(b *testing.B) {
+	for _, k := range []int{2, 8, 32} {
+		sets := benchmarkSets(k, 1000)
+		b.Run(fmt.Sprintf("nary/sets=%d", k), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				menge.UnionInt(sets...)
+			}
+		})
+		b.Run(fmt.Sprintf("chained/sets=%d", k), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				r := sets[0]
+				for _, s := range sets[1:] {
+					r = r.Union(s)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkIntersectionInt(b *testing.B) {
+	for _, k := range []int{2, 8, 32} {
+		sets := benchmarkSets(k, 1000)
+		// Make the last set the smallest, to show the effect of ordering.
+		sets[k-1] = menge.NewIntSet(sets[k-1].AsSlice()[:10]...)
+		b.Run(fmt.Sprintf("nary/sets=%d", k), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				menge.IntersectionInt(sets...)
+			}
+		})
+		b.Run(fmt.Sprintf("chained/sets=%d", k), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				r := sets[0]
+				for _, s := range sets[1:] {
+					r = r.Intersection(s)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package menge
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+)
+
+// sortElems sorts a in the natural order of T. See LessFunc for the
+// definition of the order.
+func sortElems[T comparable](a []T) {
+	sort.Sort(SortInterface(a))
+}
+
+// SortInterface returns a sort.Interface that sorts a in the natural order
+// of T, e.g., sort.Sort(sort.Reverse(SortInterface(a))) sorts a in
+// descending order. See LessFunc for the definition of the order.
+func SortInterface[T comparable](a []T) sort.Interface {
+	return naturalOrder[T]{a, LessFunc[T]()}
+}
+
+// naturalOrder implements sort.Interface for a slice in the natural order of
+// its element type.
+type naturalOrder[T comparable] struct {
+	a    []T
+	less func(a, b T) bool
+}
+
+func (o naturalOrder[T]) Len() int           { return len(o.a) }
+func (o naturalOrder[T]) Less(i, j int) bool { return o.less(o.a[i], o.a[j]) }
+func (o naturalOrder[T]) Swap(i, j int)      { o.a[i], o.a[j] = o.a[j], o.a[i] }
+
+// LessFunc returns a function that reports whether a is ordered before b in
+// the natural order of T, which is used by String, AsSortedSlice, and the
+// other methods that order the elements of a set. The order is total and is
+// defined as follows:
+//   - Integers are ordered numerically.
+//   - Strings are ordered lexically, byte-wise.
+//   - Floats are ordered numerically, except that NaN is ordered before all
+//     other values and -0 is ordered before +0.
+//   - Complex numbers are ordered by their real parts, then by their imaginary
+//     parts, each ordered as floats.
+//   - Booleans are ordered false before true.
+//   - Values of any other type are ordered lexically by their %v formatting.
+func LessFunc[T comparable]() func(a, b T) bool {
+	var zero T
+	var f any
+	switch any(zero).(type) {
+	case int:
+		f = func(a, b int) bool { return a < b }
+	case int8:
+		f = func(a, b int8) bool { return a < b }
+	case int16:
+		f = func(a, b int16) bool { return a < b }
+	case int32:
+		f = func(a, b int32) bool { return a < b }
+	case int64:
+		f = func(a, b int64) bool { return a < b }
+	case uint:
+		f = func(a, b uint) bool { return a < b }
+	case uint8:
+		f = func(a, b uint8) bool { return a < b }
+	case uint16:
+		f = func(a, b uint16) bool { return a < b }
+	case uint32:
+		f = func(a, b uint32) bool { return a < b }
+	case uint64:
+		f = func(a, b uint64) bool { return a < b }
+	case uintptr:
+		f = func(a, b uintptr) bool { return a < b }
+	case string:
+		f = func(a, b string) bool { return a < b }
+	case float32:
+		f = func(a, b float32) bool { return floatLess(float64(a), float64(b)) }
+	case float64:
+		f = floatLess
+	case complex64:
+		f = func(a, b complex64) bool { return complexLess(complex128(a), complex128(b)) }
+	case complex128:
+		f = complexLess
+	}
+	if f != nil {
+		return f.(func(a, b T) bool)
+	}
+	// Named types and types without a predefined order are compared by kind.
+	switch reflect.TypeOf(&zero).Elem().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(a, b T) bool { return reflect.ValueOf(a).Int() < reflect.ValueOf(b).Int() }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(a, b T) bool { return reflect.ValueOf(a).Uint() < reflect.ValueOf(b).Uint() }
+	case reflect.String:
+		return func(a, b T) bool { return reflect.ValueOf(a).String() < reflect.ValueOf(b).String() }
+	case reflect.Float32, reflect.Float64:
+		return func(a, b T) bool { return floatLess(reflect.ValueOf(a).Float(), reflect.ValueOf(b).Float()) }
+	case reflect.Complex64, reflect.Complex128:
+		return func(a, b T) bool { return complexLess(reflect.ValueOf(a).Complex(), reflect.ValueOf(b).Complex()) }
+	case reflect.Bool:
+		return func(a, b T) bool { return !reflect.ValueOf(a).Bool() && reflect.ValueOf(b).Bool() }
+	}
+	return func(a, b T) bool { return fmt.Sprint(a) < fmt.Sprint(b) }
+}
+
+// floatLess reports whether a is ordered before b.
+// NaN is ordered before all other values and -0 is ordered before +0.
+func floatLess(a, b float64) bool {
+	switch {
+	case math.IsNaN(a):
+		return !math.IsNaN(b)
+	case math.IsNaN(b):
+		return false
+	case a == 0 && b == 0:
+		return math.Signbit(a) && !math.Signbit(b)
+	}
+	return a < b
+}
+
+// complexLess reports whether a is ordered before b.
+// Complex numbers are ordered by their real parts, then by their imaginary
+// parts, each ordered as by floatLess.
+func complexLess(a, b complex128) bool {
+	ra, rb := real(a), real(b)
+	if floatLess(ra, rb) {
+		return true
+	}
+	if floatLess(rb, ra) {
+		return false
+	}
+	return floatLess(imag(a), imag(b))
+}
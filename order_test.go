@@ -0,0 +1,64 @@
+package menge_test
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/soroushj/menge"
+)
+
+func TestLessFunc_Float64(t *testing.T) {
+	nan, inf, negZero := math.NaN(), math.Inf(1), math.Copysign(0, -1)
+	want := []float64{nan, -inf, -1, negZero, 0, 1, inf}
+	less := menge.LessFunc[float64]()
+	for i, a := range want {
+		for j, b := range want {
+			if got := less(a, b); got != (i < j) {
+				t.Errorf("less(%v, %v) got: %v", a, b, got)
+			}
+		}
+	}
+}
+
+func TestLessFunc_Complex128(t *testing.T) {
+	want := []complex128{complex(math.NaN(), 0), complex(-1, 2), complex(0, -1), complex(0, 0), complex(0, 1), complex(1, -2)}
+	less := menge.LessFunc[complex128]()
+	for i, a := range want {
+		for j, b := range want {
+			if got := less(a, b); got != (i < j) {
+				t.Errorf("less(%v, %v) got: %v", a, b, got)
+			}
+		}
+	}
+}
+
+func TestSortInterface(t *testing.T) {
+	cases := []struct {
+		arg      []complex64
+		want     []complex64
+		wantDesc []complex64
+	}{
+		{nil, nil, nil},
+		{[]complex64{1}, []complex64{1}, []complex64{1}},
+		{[]complex64{1i, 1, -1i}, []complex64{-1i, 1i, 1}, []complex64{1, 1i, -1i}},
+	}
+	for _, c := range cases {
+		got := append([]complex64(nil), c.arg...)
+		sort.Sort(menge.SortInterface(got))
+		gotDesc := append([]complex64(nil), c.arg...)
+		sort.Sort(sort.Reverse(menge.SortInterface(gotDesc)))
+		if !reflect.DeepEqual(got, c.want) || !reflect.DeepEqual(gotDesc, c.wantDesc) {
+			t.Errorf("case: %v got: %v %v", c, got, gotDesc)
+		}
+	}
+}
+
+func TestFloat64Set_AsSortedSlice(t *testing.T) {
+	got := menge.NewFloat64Set(1, math.Inf(1), -2, math.Inf(-1)).AsSortedSlice()
+	want := []float64{math.Inf(-1), -2, 1, math.Inf(1)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v", got)
+	}
+}
@@ -0,0 +1,549 @@
+package menge
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// OrderedSet represents a set of elements of an ordered type, kept in
+// ascending order in a balanced binary search tree.
+// Besides the methods of Set, it answers order-based queries, such as Min,
+// Floor, Range, Rank, and Select, in logarithmic time, and its binary set
+// operations are linear merges of the ordered elements.
+// NaN values are ignored, as they are by Float64Set, since they are not
+// ordered. Likewise, -0 and +0 are the same element.
+// The zero value is an empty set ready to use.
+type OrderedSet[T Ordered] struct {
+	root *node[T]
+}
+
+// Add adds zero or more elements to the set.
+// NaN values are ignored.
+func (s *OrderedSet[T]) Add(elems ...T) {
+	for _, e := range elems {
+		if !isNaN(e) {
+			s.root, _ = s.root.insert(e)
+		}
+	}
+}
+
+// Remove removes zero or more elements from the set.
+func (s *OrderedSet[T]) Remove(elems ...T) {
+	for _, e := range elems {
+		if !isNaN(e) {
+			s.root, _ = s.root.delete(e)
+		}
+	}
+}
+
+// Empty empties the set.
+func (s *OrderedSet[T]) Empty() {
+	s.root = nil
+}
+
+// Has indicates whether the set has an element.
+func (s *OrderedSet[T]) Has(elem T) bool {
+	return !isNaN(elem) && s.root.find(elem) != nil
+}
+
+// Size returns the size of the set.
+func (s *OrderedSet[T]) Size() int {
+	return s.root.getSize()
+}
+
+// IsEmpty indicates whether the set is empty.
+func (s *OrderedSet[T]) IsEmpty() bool {
+	return s.root == nil
+}
+
+// Clone returns a clone of the set.
+func (s *OrderedSet[T]) Clone() *OrderedSet[T] {
+	return &OrderedSet[T]{root: s.root.clone()}
+}
+
+// AsSlice returns an equivalent slice with the elements in ascending order.
+func (s *OrderedSet[T]) AsSlice() []T {
+	return s.root.appendTo(make([]T, 0, s.Size()))
+}
+
+// AsSortedSlice returns an equivalent slice with the elements in ascending
+// order. It is the same as AsSlice.
+func (s *OrderedSet[T]) AsSortedSlice() []T {
+	return s.AsSlice()
+}
+
+// AsSortedSliceDesc returns an equivalent slice with the elements in
+// descending order.
+func (s *OrderedSet[T]) AsSortedSliceDesc() []T {
+	a := make([]T, 0, s.Size())
+	s.root.descend(func(e T) bool {
+		a = append(a, e)
+		return true
+	})
+	return a
+}
+
+// AppendTo appends the elements of the set to dst in ascending order and
+// returns the extended slice. It allocates only if dst does not have enough
+// capacity.
+func (s *OrderedSet[T]) AppendTo(dst []T) []T {
+	return s.root.appendTo(dst)
+}
+
+// String returns a string representation of the set,
+// with its elements in ascending order.
+func (s *OrderedSet[T]) String() string {
+	b := &strings.Builder{}
+	b.Grow(s.Size() * 8)
+	writeElems(b, s.AsSlice(), "%v", " ")
+	return b.String()
+}
+
+// Format implements the fmt.Formatter interface.
+// See Set.Format for the supported verbs.
+func (s *OrderedSet[T]) Format(f fmt.State, verb rune) {
+	var zero T
+	formatElems(f, verb, s.AsSlice(), "menge.NewOrderedSet["+reflect.TypeOf(zero).String()+"]")
+}
+
+// Equals indicates whether s and t are equal.
+func (s *OrderedSet[T]) Equals(t *OrderedSet[T]) bool {
+	if s.Size() != t.Size() {
+		return false
+	}
+	a, b := s.AsSlice(), t.AsSlice()
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// merge returns a new set of the elements of s and t for which keep returns
+// true, given whether they are in s and t.
+func (s *OrderedSet[T]) merge(t *OrderedSet[T], keep func(inS, inT bool) bool) *OrderedSet[T] {
+	var a []T
+	mergeSorted(s.AsSlice(), t.AsSlice(), func(e T, inS, inT bool) {
+		if keep(inS, inT) {
+			a = append(a, e)
+		}
+	})
+	return &OrderedSet[T]{root: build(a)}
+}
+
+// Union returns the union of s and t.
+func (s *OrderedSet[T]) Union(t *OrderedSet[T]) *OrderedSet[T] {
+	return s.merge(t, func(inS, inT bool) bool { return true })
+}
+
+// Intersection returns the intersection of s and t.
+func (s *OrderedSet[T]) Intersection(t *OrderedSet[T]) *OrderedSet[T] {
+	return s.merge(t, func(inS, inT bool) bool { return inS && inT })
+}
+
+// Difference returns the difference of s and t, i.e., s - t.
+func (s *OrderedSet[T]) Difference(t *OrderedSet[T]) *OrderedSet[T] {
+	return s.merge(t, func(inS, inT bool) bool { return !inT })
+}
+
+// SymmetricDifference returns the symmetric difference of s and t,
+// i.e., the elements that are in either s or t but not in both.
+func (s *OrderedSet[T]) SymmetricDifference(t *OrderedSet[T]) *OrderedSet[T] {
+	return s.merge(t, func(inS, inT bool) bool { return inS != inT })
+}
+
+// UnionWith adds the elements of t to s, i.e., s = s ⋃ t.
+func (s *OrderedSet[T]) UnionWith(t *OrderedSet[T]) {
+	s.root = s.Union(t).root
+}
+
+// IntersectWith removes the elements of s that are not in t, i.e., s = s ⋂ t.
+func (s *OrderedSet[T]) IntersectWith(t *OrderedSet[T]) {
+	s.root = s.Intersection(t).root
+}
+
+// DifferenceWith removes the elements of t from s, i.e., s = s - t.
+func (s *OrderedSet[T]) DifferenceWith(t *OrderedSet[T]) {
+	s.root = s.Difference(t).root
+}
+
+// SymmetricDifferenceWith replaces s with the symmetric difference of s and t,
+// i.e., removes the elements of t that are in s and adds those that are not.
+func (s *OrderedSet[T]) SymmetricDifferenceWith(t *OrderedSet[T]) {
+	s.root = s.SymmetricDifference(t).root
+}
+
+// IsSubsetOf indicates whether s is a subset of t.
+func (s *OrderedSet[T]) IsSubsetOf(t *OrderedSet[T]) bool {
+	return s.Size() <= t.Size() && s.IntersectionSize(t) == s.Size()
+}
+
+// IsProperSubsetOf indicates whether s is a proper subset of t.
+func (s *OrderedSet[T]) IsProperSubsetOf(t *OrderedSet[T]) bool {
+	return s.Size() < t.Size() && s.IsSubsetOf(t)
+}
+
+// IsSupersetOf indicates whether s is a superset of t.
+func (s *OrderedSet[T]) IsSupersetOf(t *OrderedSet[T]) bool {
+	return t.IsSubsetOf(s)
+}
+
+// IsProperSupersetOf indicates whether s is a proper superset of t.
+func (s *OrderedSet[T]) IsProperSupersetOf(t *OrderedSet[T]) bool {
+	return t.IsProperSubsetOf(s)
+}
+
+// IsDisjointFrom indicates whether s and t are disjoint.
+func (s *OrderedSet[T]) IsDisjointFrom(t *OrderedSet[T]) bool {
+	return s.IntersectionSize(t) == 0
+}
+
+// IntersectionSize returns the size of the intersection of s and t,
+// without computing the intersection.
+func (s *OrderedSet[T]) IntersectionSize(t *OrderedSet[T]) int {
+	n := 0
+	mergeSorted(s.AsSlice(), t.AsSlice(), func(e T, inS, inT bool) {
+		if inS && inT {
+			n++
+		}
+	})
+	return n
+}
+
+// UnionSize returns the size of the union of s and t,
+// without computing the union.
+func (s *OrderedSet[T]) UnionSize(t *OrderedSet[T]) int {
+	return s.Size() + t.Size() - s.IntersectionSize(t)
+}
+
+// DifferenceSize returns the size of the difference of s and t, i.e., s - t,
+// without computing the difference.
+func (s *OrderedSet[T]) DifferenceSize(t *OrderedSet[T]) int {
+	return s.Size() - s.IntersectionSize(t)
+}
+
+// Jaccard returns the Jaccard index of s and t, i.e., |s ⋂ t| / |s ⋃ t|.
+// The Jaccard index of two empty sets is 1.
+func (s *OrderedSet[T]) Jaccard(t *OrderedSet[T]) float64 {
+	return jaccard(s.IntersectionSize(t), s.Size(), t.Size())
+}
+
+// SorensenDice returns the Sørensen–Dice coefficient of s and t,
+// i.e., 2|s ⋂ t| / (|s| + |t|).
+// The Sørensen–Dice coefficient of two empty sets is 1.
+func (s *OrderedSet[T]) SorensenDice(t *OrderedSet[T]) float64 {
+	return sorensenDice(s.IntersectionSize(t), s.Size(), t.Size())
+}
+
+// OverlapCoefficient returns the overlap coefficient of s and t,
+// i.e., |s ⋂ t| / min(|s|, |t|).
+// The overlap coefficient of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s *OrderedSet[T]) OverlapCoefficient(t *OrderedSet[T]) float64 {
+	return overlapCoefficient(s.IntersectionSize(t), s.Size(), t.Size())
+}
+
+// CosineSimilarity returns the cosine similarity of s and t,
+// i.e., |s ⋂ t| / √(|s||t|).
+// The cosine similarity of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s *OrderedSet[T]) CosineSimilarity(t *OrderedSet[T]) float64 {
+	return cosineSimilarity(s.IntersectionSize(t), s.Size(), t.Size())
+}
+
+// Filter returns a new set containing the elements of s for which pred
+// returns true.
+func (s *OrderedSet[T]) Filter(pred func(elem T) bool) *OrderedSet[T] {
+	var a []T
+	s.root.ascend(func(e T) bool {
+		if pred(e) {
+			a = append(a, e)
+		}
+		return true
+	})
+	return &OrderedSet[T]{root: build(a)}
+}
+
+// Retain removes the elements of s for which pred returns false.
+func (s *OrderedSet[T]) Retain(pred func(elem T) bool) {
+	s.root = s.Filter(pred).root
+}
+
+// RemoveIf removes the elements of s for which pred returns true.
+func (s *OrderedSet[T]) RemoveIf(pred func(elem T) bool) {
+	s.root = s.Filter(func(e T) bool { return !pred(e) }).root
+}
+
+// Partition returns two new sets containing the elements of s for which pred
+// returns true and false, respectively.
+func (s *OrderedSet[T]) Partition(pred func(elem T) bool) (in, out *OrderedSet[T]) {
+	var a, b []T
+	s.root.ascend(func(e T) bool {
+		if pred(e) {
+			a = append(a, e)
+		} else {
+			b = append(b, e)
+		}
+		return true
+	})
+	return &OrderedSet[T]{root: build(a)}, &OrderedSet[T]{root: build(b)}
+}
+
+// Any indicates whether pred returns true for any element of s.
+// It returns false for an empty set.
+func (s *OrderedSet[T]) Any(pred func(elem T) bool) bool {
+	return !s.None(pred)
+}
+
+// Every indicates whether pred returns true for all elements of s.
+// It returns true for an empty set.
+func (s *OrderedSet[T]) Every(pred func(elem T) bool) bool {
+	return s.root.ascend(pred)
+}
+
+// None indicates whether pred returns false for all elements of s.
+// It returns true for an empty set.
+func (s *OrderedSet[T]) None(pred func(elem T) bool) bool {
+	return s.root.ascend(func(e T) bool { return !pred(e) })
+}
+
+// Count returns the number of elements of s for which pred returns true.
+func (s *OrderedSet[T]) Count(pred func(elem T) bool) int {
+	n := 0
+	s.root.ascend(func(e T) bool {
+		if pred(e) {
+			n++
+		}
+		return true
+	})
+	return n
+}
+
+// Min returns the least element of the set,
+// or false if the set is empty.
+func (s *OrderedSet[T]) Min() (T, bool) {
+	return s.Select(0)
+}
+
+// Max returns the greatest element of the set,
+// or false if the set is empty.
+func (s *OrderedSet[T]) Max() (T, bool) {
+	return s.Select(s.Size() - 1)
+}
+
+// Floor returns the greatest element of the set that is less than or equal
+// to elem, or false if there is no such element.
+func (s *OrderedSet[T]) Floor(elem T) (T, bool) {
+	return s.found(elem, func(e T) *node[T] { return s.root.floor(e, true) })
+}
+
+// Ceiling returns the least element of the set that is greater than or equal
+// to elem, or false if there is no such element.
+func (s *OrderedSet[T]) Ceiling(elem T) (T, bool) {
+	return s.found(elem, func(e T) *node[T] { return s.root.ceiling(e, true) })
+}
+
+// Lower returns the greatest element of the set that is less than elem,
+// or false if there is no such element.
+func (s *OrderedSet[T]) Lower(elem T) (T, bool) {
+	return s.found(elem, func(e T) *node[T] { return s.root.floor(e, false) })
+}
+
+// Higher returns the least element of the set that is greater than elem,
+// or false if there is no such element.
+func (s *OrderedSet[T]) Higher(elem T) (T, bool) {
+	return s.found(elem, func(e T) *node[T] { return s.root.ceiling(e, false) })
+}
+
+// found returns the element of the node that find returns for elem, or false
+// if elem is NaN or find returns nil.
+func (s *OrderedSet[T]) found(elem T, find func(T) *node[T]) (T, bool) {
+	var zero T
+	if isNaN(elem) {
+		return zero, false
+	}
+	n := find(elem)
+	if n == nil {
+		return zero, false
+	}
+	return n.elem, true
+}
+
+// Range returns the elements e of the set such that lo <= e < hi,
+// in ascending order.
+func (s *OrderedSet[T]) Range(lo, hi T) []T {
+	var a []T
+	s.AscendRange(lo, hi, func(e T) bool {
+		a = append(a, e)
+		return true
+	})
+	return a
+}
+
+// Rank returns the number of elements of the set that are less than elem,
+// which is the index of elem in AsSlice if the set has elem.
+func (s *OrderedSet[T]) Rank(elem T) int {
+	if isNaN(elem) {
+		return 0
+	}
+	return s.root.rank(elem)
+}
+
+// Select returns the k-th least element of the set, counting from zero,
+// or false if k is out of range. It is the inverse of Rank.
+func (s *OrderedSet[T]) Select(k int) (T, bool) {
+	if k < 0 || k >= s.Size() {
+		var zero T
+		return zero, false
+	}
+	return s.root.at(k).elem, true
+}
+
+// Ascend calls f for each element of the set in ascending order,
+// until f returns false.
+func (s *OrderedSet[T]) Ascend(f func(elem T) bool) {
+	s.root.ascend(f)
+}
+
+// Descend calls f for each element of the set in descending order,
+// until f returns false.
+func (s *OrderedSet[T]) Descend(f func(elem T) bool) {
+	s.root.descend(f)
+}
+
+// AscendRange calls f for each element e of the set such that lo <= e < hi,
+// in ascending order, until f returns false.
+func (s *OrderedSet[T]) AscendRange(lo, hi T, f func(elem T) bool) {
+	if !isNaN(lo) && !isNaN(hi) {
+		s.root.ascendRange(lo, hi, f)
+	}
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// See Set.MarshalJSON for the encoding.
+func (s *OrderedSet[T]) MarshalJSON() ([]byte, error) {
+	return marshalElems(s.AsSlice())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It replaces the elements of the set with the decoded ones.
+func (s *OrderedSet[T]) UnmarshalJSON(data []byte) error {
+	var t Set[T]
+	if err := json.Unmarshal(data, &t); err != nil || t == nil {
+		return err
+	}
+	s.root = build(withoutNaN(t).AsSortedSlice())
+	return nil
+}
+
+// NewOrderedSet returns a new OrderedSet containing zero or more elements.
+func NewOrderedSet[T Ordered](elems ...T) *OrderedSet[T] {
+	s := &OrderedSet[T]{}
+	s.Add(elems...)
+	return s
+}
+
+// Ordered sets of all ordered basic types.
+type (
+	// OrderedFloat32Set represents an ordered set of float32 elements.
+	OrderedFloat32Set = OrderedSet[float32]
+	// OrderedFloat64Set represents an ordered set of float64 elements.
+	OrderedFloat64Set = OrderedSet[float64]
+	// OrderedIntSet represents an ordered set of int elements.
+	OrderedIntSet = OrderedSet[int]
+	// OrderedInt16Set represents an ordered set of int16 elements.
+	OrderedInt16Set = OrderedSet[int16]
+	// OrderedInt32Set represents an ordered set of int32 elements.
+	OrderedInt32Set = OrderedSet[int32]
+	// OrderedInt64Set represents an ordered set of int64 elements.
+	OrderedInt64Set = OrderedSet[int64]
+	// OrderedInt8Set represents an ordered set of int8 elements.
+	OrderedInt8Set = OrderedSet[int8]
+	// OrderedStringSet represents an ordered set of string elements.
+	OrderedStringSet = OrderedSet[string]
+	// OrderedUIntSet represents an ordered set of uint elements.
+	OrderedUIntSet = OrderedSet[uint]
+	// OrderedUInt16Set represents an ordered set of uint16 elements.
+	OrderedUInt16Set = OrderedSet[uint16]
+	// OrderedUInt32Set represents an ordered set of uint32 elements.
+	OrderedUInt32Set = OrderedSet[uint32]
+	// OrderedUInt64Set represents an ordered set of uint64 elements.
+	OrderedUInt64Set = OrderedSet[uint64]
+	// OrderedUInt8Set represents an ordered set of uint8 elements.
+	OrderedUInt8Set = OrderedSet[uint8]
+	// OrderedUIntPtrSet represents an ordered set of uintptr elements.
+	OrderedUIntPtrSet = OrderedSet[uintptr]
+)
+
+// NewOrderedFloat32Set returns a new OrderedFloat32Set containing zero or more elements.
+func NewOrderedFloat32Set(elems ...float32) *OrderedFloat32Set {
+	return NewOrderedSet(elems...)
+}
+
+// NewOrderedFloat64Set returns a new OrderedFloat64Set containing zero or more elements.
+func NewOrderedFloat64Set(elems ...float64) *OrderedFloat64Set {
+	return NewOrderedSet(elems...)
+}
+
+// NewOrderedIntSet returns a new OrderedIntSet containing zero or more elements.
+func NewOrderedIntSet(elems ...int) *OrderedIntSet {
+	return NewOrderedSet(elems...)
+}
+
+// NewOrderedInt16Set returns a new OrderedInt16Set containing zero or more elements.
+func NewOrderedInt16Set(elems ...int16) *OrderedInt16Set {
+	return NewOrderedSet(elems...)
+}
+
+// NewOrderedInt32Set returns a new OrderedInt32Set containing zero or more elements.
+func NewOrderedInt32Set(elems ...int32) *OrderedInt32Set {
+	return NewOrderedSet(elems...)
+}
+
+// NewOrderedInt64Set returns a new OrderedInt64Set containing zero or more elements.
+func NewOrderedInt64Set(elems ...int64) *OrderedInt64Set {
+	return NewOrderedSet(elems...)
+}
+
+// NewOrderedInt8Set returns a new OrderedInt8Set containing zero or more elements.
+func NewOrderedInt8Set(elems ...int8) *OrderedInt8Set {
+	return NewOrderedSet(elems...)
+}
+
+// NewOrderedStringSet returns a new OrderedStringSet containing zero or more elements.
+func NewOrderedStringSet(elems ...string) *OrderedStringSet {
+	return NewOrderedSet(elems...)
+}
+
+// NewOrderedUIntSet returns a new OrderedUIntSet containing zero or more elements.
+func NewOrderedUIntSet(elems ...uint) *OrderedUIntSet {
+	return NewOrderedSet(elems...)
+}
+
+// NewOrderedUInt16Set returns a new OrderedUInt16Set containing zero or more elements.
+func NewOrderedUInt16Set(elems ...uint16) *OrderedUInt16Set {
+	return NewOrderedSet(elems...)
+}
+
+// NewOrderedUInt32Set returns a new OrderedUInt32Set containing zero or more elements.
+func NewOrderedUInt32Set(elems ...uint32) *OrderedUInt32Set {
+	return NewOrderedSet(elems...)
+}
+
+// NewOrderedUInt64Set returns a new OrderedUInt64Set containing zero or more elements.
+func NewOrderedUInt64Set(elems ...uint64) *OrderedUInt64Set {
+	return NewOrderedSet(elems...)
+}
+
+// NewOrderedUInt8Set returns a new OrderedUInt8Set containing zero or more elements.
+func NewOrderedUInt8Set(elems ...uint8) *OrderedUInt8Set {
+	return NewOrderedSet(elems...)
+}
+
+// NewOrderedUIntPtrSet returns a new OrderedUIntPtrSet containing zero or more elements.
+func NewOrderedUIntPtrSet(elems ...uintptr) *OrderedUIntPtrSet {
+	return NewOrderedSet(elems...)
+}
@@ -0,0 +1,225 @@
+package menge_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/soroushj/menge"
+)
+
+// TestOrderedSet compares the results of OrderedSet with those of Set.
+func TestOrderedSet(t *testing.T) {
+	sets := [][]int{{}, {1}, {2}, {1, 2}, {2, 3}, {1, 2, 3}}
+	for _, a := range sets {
+		for _, b := range sets {
+			s, u := menge.NewOrderedIntSet(a...), menge.NewOrderedIntSet(b...)
+			ms, mu := menge.NewSet(a...), menge.NewSet(b...)
+			if got, want := s.Equals(u), ms.Equals(mu); got != want {
+				t.Errorf("%v Equals %v got: %v", a, b, got)
+			}
+			for _, op := range []struct {
+				name string
+				f    func(s, u *menge.OrderedIntSet) *menge.OrderedIntSet
+				g    func(s, u menge.Set[int]) menge.Set[int]
+			}{
+				{"Union", (*menge.OrderedIntSet).Union, menge.Set[int].Union},
+				{"Intersection", (*menge.OrderedIntSet).Intersection, menge.Set[int].Intersection},
+				{"Difference", (*menge.OrderedIntSet).Difference, menge.Set[int].Difference},
+				{"SymmetricDifference", (*menge.OrderedIntSet).SymmetricDifference, menge.Set[int].SymmetricDifference},
+			} {
+				if got, want := op.f(s, u).AsSlice(), op.g(ms, mu).AsSortedSlice(); !reflect.DeepEqual(got, want) {
+					t.Errorf("%v %s %v got: %v", a, op.name, b, got)
+				}
+			}
+			for _, op := range []struct {
+				name string
+				f    func(s, u *menge.OrderedIntSet)
+				g    func(s, u menge.Set[int])
+			}{
+				{"UnionWith", (*menge.OrderedIntSet).UnionWith, menge.Set[int].UnionWith},
+				{"IntersectWith", (*menge.OrderedIntSet).IntersectWith, menge.Set[int].IntersectWith},
+				{"DifferenceWith", (*menge.OrderedIntSet).DifferenceWith, menge.Set[int].DifferenceWith},
+				{"SymmetricDifferenceWith", (*menge.OrderedIntSet).SymmetricDifferenceWith, menge.Set[int].SymmetricDifferenceWith},
+			} {
+				got, want := s.Clone(), ms.Clone()
+				op.f(got, u)
+				op.g(want, mu)
+				if !reflect.DeepEqual(got.AsSlice(), want.AsSortedSlice()) {
+					t.Errorf("%v %s %v got: %v", a, op.name, b, got)
+				}
+			}
+			for _, op := range []struct {
+				name string
+				f    func(s, u *menge.OrderedIntSet) bool
+				g    func(s, u menge.Set[int]) bool
+			}{
+				{"IsSubsetOf", (*menge.OrderedIntSet).IsSubsetOf, menge.Set[int].IsSubsetOf},
+				{"IsProperSubsetOf", (*menge.OrderedIntSet).IsProperSubsetOf, menge.Set[int].IsProperSubsetOf},
+				{"IsSupersetOf", (*menge.OrderedIntSet).IsSupersetOf, menge.Set[int].IsSupersetOf},
+				{"IsProperSupersetOf", (*menge.OrderedIntSet).IsProperSupersetOf, menge.Set[int].IsProperSupersetOf},
+				{"IsDisjointFrom", (*menge.OrderedIntSet).IsDisjointFrom, menge.Set[int].IsDisjointFrom},
+			} {
+				if got, want := op.f(s, u), op.g(ms, mu); got != want {
+					t.Errorf("%v %s %v got: %v", a, op.name, b, got)
+				}
+			}
+			if got, want := s.UnionSize(u)+s.DifferenceSize(u), ms.UnionSize(mu)+ms.DifferenceSize(mu); got != want {
+				t.Errorf("%v sizes %v got: %v", a, b, got)
+			}
+			if got, want := s.Jaccard(u)+s.SorensenDice(u)+s.OverlapCoefficient(u)+s.CosineSimilarity(u),
+				ms.Jaccard(mu)+ms.SorensenDice(mu)+ms.OverlapCoefficient(mu)+ms.CosineSimilarity(mu); got != want {
+				t.Errorf("%v similarity %v got: %v", a, b, got)
+			}
+		}
+		s, ms := menge.NewOrderedIntSet(a...), menge.NewSet(a...)
+		if s.Size() != ms.Size() || s.IsEmpty() != ms.IsEmpty() || s.String() != ms.String() {
+			t.Errorf("%v got: %v", a, s)
+		}
+		if !reflect.DeepEqual(s.AsSortedSliceDesc(), ms.AsSortedSliceDesc()) || !reflect.DeepEqual(s.AppendTo([]int{0}), append([]int{0}, ms.AsSortedSlice()...)) {
+			t.Errorf("%v slices got: %v", a, s)
+		}
+		odd := func(e int) bool { return e%2 == 1 }
+		in, out := s.Partition(odd)
+		if !reflect.DeepEqual(in.AsSlice(), ms.Filter(odd).AsSortedSlice()) || in.Size()+out.Size() != s.Size() || !s.Filter(odd).Equals(in) {
+			t.Errorf("%v Partition got: %v %v", a, in, out)
+		}
+		if s.Any(odd) != ms.Any(odd) || s.Every(odd) != ms.Every(odd) || s.None(odd) != ms.None(odd) || s.Count(odd) != ms.Count(odd) {
+			t.Errorf("%v predicates got: %v %v %v %v", a, s.Any(odd), s.Every(odd), s.None(odd), s.Count(odd))
+		}
+		r := s.Clone()
+		r.Retain(odd)
+		if !r.Equals(in) {
+			t.Errorf("%v Retain got: %v", a, r)
+		}
+		r = s.Clone()
+		r.RemoveIf(odd)
+		if !r.Equals(out) {
+			t.Errorf("%v RemoveIf got: %v", a, r)
+		}
+	}
+}
+
+func TestOrderedSet_Mutations(t *testing.T) {
+	var s menge.OrderedStringSet
+	if !s.IsEmpty() || s.Has("a") {
+		t.Errorf("zero value got: %v", &s)
+	}
+	s.Add("c", "a", "b", "a")
+	if got := s.AsSlice(); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("Add got: %v", got)
+	}
+	c := s.Clone()
+	s.Remove("b", "x")
+	if got := s.AsSlice(); !reflect.DeepEqual(got, []string{"a", "c"}) || c.Size() != 3 {
+		t.Errorf("Remove got: %v %v", got, c)
+	}
+	s.Empty()
+	if !s.IsEmpty() || s.Size() != 0 {
+		t.Errorf("Empty got: %v", &s)
+	}
+}
+
+func TestOrderedSet_Queries(t *testing.T) {
+	s := menge.NewOrderedIntSet(40, 10, 30, 20)
+	type result struct {
+		e  int
+		ok bool
+	}
+	cases := []struct {
+		arg                           int
+		floor, ceiling, lower, higher result
+		rank                          int
+	}{
+		{5, result{0, false}, result{10, true}, result{0, false}, result{10, true}, 0},
+		{10, result{10, true}, result{10, true}, result{0, false}, result{20, true}, 0},
+		{25, result{20, true}, result{30, true}, result{20, true}, result{30, true}, 2},
+		{40, result{40, true}, result{40, true}, result{30, true}, result{0, false}, 3},
+		{45, result{40, true}, result{0, false}, result{40, true}, result{0, false}, 4},
+	}
+	for _, c := range cases {
+		var got [4]result
+		got[0].e, got[0].ok = s.Floor(c.arg)
+		got[1].e, got[1].ok = s.Ceiling(c.arg)
+		got[2].e, got[2].ok = s.Lower(c.arg)
+		got[3].e, got[3].ok = s.Higher(c.arg)
+		if got != [4]result{c.floor, c.ceiling, c.lower, c.higher} || s.Rank(c.arg) != c.rank {
+			t.Errorf("case: %v got: %v %v", c, got, s.Rank(c.arg))
+		}
+	}
+	for k := -1; k <= s.Size(); k++ {
+		e, ok := s.Select(k)
+		if ok != (k >= 0 && k < s.Size()) || ok && (e != 10*(k+1) || s.Rank(e) != k) {
+			t.Errorf("Select(%d) got: %v %v", k, e, ok)
+		}
+	}
+	if min, ok := s.Min(); min != 10 || !ok {
+		t.Errorf("Min got: %v %v", min, ok)
+	}
+	if max, ok := s.Max(); max != 40 || !ok {
+		t.Errorf("Max got: %v %v", max, ok)
+	}
+	if _, ok := menge.NewOrderedIntSet().Min(); ok {
+		t.Error("Min of empty set got: true")
+	}
+	ranges := []struct {
+		lo, hi int
+		want   []int
+	}{
+		{0, 100, []int{10, 20, 30, 40}},
+		{10, 40, []int{10, 20, 30}},
+		{11, 40, []int{20, 30}},
+		{20, 20, nil},
+		{30, 20, nil},
+	}
+	for _, c := range ranges {
+		if got := s.Range(c.lo, c.hi); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+	var desc []int
+	s.Descend(func(e int) bool {
+		desc = append(desc, e)
+		return e > 30
+	})
+	if !reflect.DeepEqual(desc, []int{40, 30}) {
+		t.Errorf("Descend got: %v", desc)
+	}
+}
+
+func TestOrderedSet_NaN(t *testing.T) {
+	nan := math.NaN()
+	s := menge.NewOrderedFloat64Set(nan, 1, 2, nan)
+	if s.Size() != 2 || s.Has(nan) || s.Rank(nan) != 0 || s.Range(nan, 3) != nil {
+		t.Errorf("got: %v", s)
+	}
+	for _, f := range []func(float64) (float64, bool){s.Floor, s.Ceiling, s.Lower, s.Higher} {
+		if _, ok := f(nan); ok {
+			t.Errorf("query for NaN got: true")
+		}
+	}
+	s.Add(math.Copysign(0, -1), 0)
+	if s.Size() != 3 {
+		t.Errorf("-0 and +0 got: %v", s)
+	}
+}
+
+func TestOrderedSet_Encoding(t *testing.T) {
+	if got := fmt.Sprintf("%#v", menge.NewOrderedFloat64Set(2, -1.5, 1)); got != "menge.NewOrderedSet[float64](-1.5, 1, 2)" {
+		t.Errorf("Format got: %s", got)
+	}
+	s := menge.NewOrderedFloat64Set(2, math.Inf(-1), 1)
+	if got := fmt.Sprintf("%v %+v", s, s); got != "{-Inf 1 2} {-Inf 1 2} (size 3)" {
+		t.Errorf("Format got: %s", got)
+	}
+	data, err := json.Marshal(s)
+	if err != nil || string(data) != `["-Inf",1,2]` {
+		t.Errorf("MarshalJSON got: %s %v", data, err)
+	}
+	u := menge.NewOrderedFloat64Set(5)
+	if err := json.Unmarshal([]byte(`[2,"NaN",1,2]`), u); err != nil || !reflect.DeepEqual(u.AsSlice(), []float64{1, 2}) {
+		t.Errorf("UnmarshalJSON got: %v %v", u, err)
+	}
+}
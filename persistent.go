@@ -0,0 +1,471 @@
+package menge
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// PersistentSet represents an immutable set of elements of a basic type,
+// stored in a hash trie. Its methods never modify the set; With, Without,
+// and the binary set operations return new sets that share most of their
+// structure with the sets they are derived from, so a PersistentSet can be
+// passed around and read by multiple goroutines without copying or locking.
+// A PersistentSet is a small value; copying it is a constant-time snapshot.
+// NaN values are ignored, as they are by Float64Set, since they are not
+// equal to themselves.
+// The zero value is an empty set ready to use.
+type PersistentSet[T Ordered | Complex] struct {
+	root *champ[T]
+	size int
+}
+
+// With returns a set with the elements of s and zero or more elements.
+// NaN values are ignored.
+func (s PersistentSet[T]) With(elems ...T) PersistentSet[T] {
+	for _, e := range elems {
+		if isNaN(e) {
+			continue
+		}
+		var added bool
+		if s.root, added = s.root.with(e, elemHash(e), 0); added {
+			s.size++
+		}
+	}
+	return s
+}
+
+// Without returns a set with the elements of s except zero or more elements.
+func (s PersistentSet[T]) Without(elems ...T) PersistentSet[T] {
+	for _, e := range elems {
+		if isNaN(e) {
+			continue
+		}
+		var removed bool
+		if s.root, removed = s.root.without(e, elemHash(e), 0); removed {
+			s.size--
+		}
+	}
+	if s.size == 0 {
+		s.root = nil
+	}
+	return s
+}
+
+// Has indicates whether the set has an element.
+func (s PersistentSet[T]) Has(elem T) bool {
+	return s.root.has(elem, elemHash(elem), 0)
+}
+
+// Size returns the size of the set.
+func (s PersistentSet[T]) Size() int {
+	return s.size
+}
+
+// IsEmpty indicates whether the set is empty.
+func (s PersistentSet[T]) IsEmpty() bool {
+	return s.size == 0
+}
+
+// AsSlice returns an equivalent slice with no specific order of the elements.
+func (s PersistentSet[T]) AsSlice() []T {
+	return s.AppendTo(make([]T, 0, s.size))
+}
+
+// AsSortedSlice returns an equivalent slice with the elements in ascending
+// order. See LessFunc for the order.
+func (s PersistentSet[T]) AsSortedSlice() []T {
+	a := s.AsSlice()
+	sortElems(a)
+	return a
+}
+
+// AsSortedSliceDesc returns an equivalent slice with the elements in
+// descending order. See LessFunc for the order.
+func (s PersistentSet[T]) AsSortedSliceDesc() []T {
+	a := s.AsSortedSlice()
+	for i, j := 0, len(a)-1; i < j; i, j = i+1, j-1 {
+		a[i], a[j] = a[j], a[i]
+	}
+	return a
+}
+
+// AppendTo appends the elements of the set to dst, with no specific order,
+// and returns the extended slice. It allocates only if dst does not have
+// enough capacity.
+func (s PersistentSet[T]) AppendTo(dst []T) []T {
+	s.root.each(func(e T) bool {
+		dst = append(dst, e)
+		return true
+	})
+	return dst
+}
+
+// ToSet returns an equivalent Set. It can be converted to the set type of T,
+// e.g., IntSet(s.ToSet()) for a PersistentIntSet.
+func (s PersistentSet[T]) ToSet() Set[T] {
+	r := make(Set[T], s.size)
+	s.root.each(func(e T) bool {
+		r[e] = struct{}{}
+		return true
+	})
+	return r
+}
+
+// String returns a string representation of the set,
+// with its elements in ascending order.
+func (s PersistentSet[T]) String() string {
+	b := &strings.Builder{}
+	writeElems(b, s.AsSortedSlice(), "%v", " ")
+	return b.String()
+}
+
+// Format implements the fmt.Formatter interface.
+// See Set.Format for the supported verbs.
+func (s PersistentSet[T]) Format(f fmt.State, verb rune) {
+	var zero T
+	formatElems(f, verb, s.AsSortedSlice(), "menge.NewPersistentSet["+reflect.TypeOf(zero).String()+"]")
+}
+
+// Equals indicates whether s and t are equal.
+func (s PersistentSet[T]) Equals(t PersistentSet[T]) bool {
+	return s.root == t.root || s.size == t.size && s.IsSubsetOf(t)
+}
+
+// Union returns the union of s and t.
+// It shares the structure of the larger of s and t.
+func (s PersistentSet[T]) Union(t PersistentSet[T]) PersistentSet[T] {
+	if s.size < t.size {
+		s, t = t, s
+	}
+	t.root.each(func(e T) bool {
+		s = s.With(e)
+		return true
+	})
+	return s
+}
+
+// Intersection returns the intersection of s and t.
+func (s PersistentSet[T]) Intersection(t PersistentSet[T]) PersistentSet[T] {
+	if s.size > t.size {
+		s, t = t, s
+	}
+	return s.Filter(t.Has)
+}
+
+// Difference returns the difference of s and t, i.e., s - t.
+// It shares the structure of s.
+func (s PersistentSet[T]) Difference(t PersistentSet[T]) PersistentSet[T] {
+	if s.size <= t.size {
+		return s.Filter(func(e T) bool { return !t.Has(e) })
+	}
+	t.root.each(func(e T) bool {
+		s = s.Without(e)
+		return true
+	})
+	return s
+}
+
+// SymmetricDifference returns the symmetric difference of s and t,
+// i.e., the elements that are in either s or t but not in both.
+func (s PersistentSet[T]) SymmetricDifference(t PersistentSet[T]) PersistentSet[T] {
+	if s.size < t.size {
+		s, t = t, s
+	}
+	t.root.each(func(e T) bool {
+		if s.Has(e) {
+			s = s.Without(e)
+		} else {
+			s = s.With(e)
+		}
+		return true
+	})
+	return s
+}
+
+// IsSubsetOf indicates whether s is a subset of t.
+func (s PersistentSet[T]) IsSubsetOf(t PersistentSet[T]) bool {
+	return s.size <= t.size && s.Every(t.Has)
+}
+
+// IsProperSubsetOf indicates whether s is a proper subset of t.
+func (s PersistentSet[T]) IsProperSubsetOf(t PersistentSet[T]) bool {
+	return s.size < t.size && s.Every(t.Has)
+}
+
+// IsSupersetOf indicates whether s is a superset of t.
+func (s PersistentSet[T]) IsSupersetOf(t PersistentSet[T]) bool {
+	return t.IsSubsetOf(s)
+}
+
+// IsProperSupersetOf indicates whether s is a proper superset of t.
+func (s PersistentSet[T]) IsProperSupersetOf(t PersistentSet[T]) bool {
+	return t.IsProperSubsetOf(s)
+}
+
+// IsDisjointFrom indicates whether s and t are disjoint.
+func (s PersistentSet[T]) IsDisjointFrom(t PersistentSet[T]) bool {
+	if s.size > t.size {
+		s, t = t, s
+	}
+	return s.None(t.Has)
+}
+
+// IntersectionSize returns the size of the intersection of s and t,
+// without computing the intersection.
+func (s PersistentSet[T]) IntersectionSize(t PersistentSet[T]) int {
+	if s.size > t.size {
+		s, t = t, s
+	}
+	return s.Count(t.Has)
+}
+
+// UnionSize returns the size of the union of s and t,
+// without computing the union.
+func (s PersistentSet[T]) UnionSize(t PersistentSet[T]) int {
+	return s.size + t.size - s.IntersectionSize(t)
+}
+
+// DifferenceSize returns the size of the difference of s and t, i.e., s - t,
+// without computing the difference.
+func (s PersistentSet[T]) DifferenceSize(t PersistentSet[T]) int {
+	return s.size - s.IntersectionSize(t)
+}
+
+// Jaccard returns the Jaccard index of s and t, i.e., |s ⋂ t| / |s ⋃ t|.
+// The Jaccard index of two empty sets is 1.
+func (s PersistentSet[T]) Jaccard(t PersistentSet[T]) float64 {
+	return jaccard(s.IntersectionSize(t), s.size, t.size)
+}
+
+// SorensenDice returns the Sørensen–Dice coefficient of s and t,
+// i.e., 2|s ⋂ t| / (|s| + |t|).
+// The Sørensen–Dice coefficient of two empty sets is 1.
+func (s PersistentSet[T]) SorensenDice(t PersistentSet[T]) float64 {
+	return sorensenDice(s.IntersectionSize(t), s.size, t.size)
+}
+
+// OverlapCoefficient returns the overlap coefficient of s and t,
+// i.e., |s ⋂ t| / min(|s|, |t|).
+// The overlap coefficient of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s PersistentSet[T]) OverlapCoefficient(t PersistentSet[T]) float64 {
+	return overlapCoefficient(s.IntersectionSize(t), s.size, t.size)
+}
+
+// CosineSimilarity returns the cosine similarity of s and t,
+// i.e., |s ⋂ t| / √(|s||t|).
+// The cosine similarity of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s PersistentSet[T]) CosineSimilarity(t PersistentSet[T]) float64 {
+	return cosineSimilarity(s.IntersectionSize(t), s.size, t.size)
+}
+
+// Filter returns a set containing the elements of s for which pred returns
+// true. It shares the structure of s.
+func (s PersistentSet[T]) Filter(pred func(elem T) bool) PersistentSet[T] {
+	var out []T
+	s.root.each(func(e T) bool {
+		if !pred(e) {
+			out = append(out, e)
+		}
+		return true
+	})
+	return s.Without(out...)
+}
+
+// Partition returns two sets containing the elements of s for which pred
+// returns true and false, respectively.
+func (s PersistentSet[T]) Partition(pred func(elem T) bool) (in, out PersistentSet[T]) {
+	var ins, outs []T
+	s.root.each(func(e T) bool {
+		if pred(e) {
+			ins = append(ins, e)
+		} else {
+			outs = append(outs, e)
+		}
+		return true
+	})
+	return s.Without(outs...), s.Without(ins...)
+}
+
+// Any indicates whether pred returns true for any element of s.
+// It returns false for an empty set.
+func (s PersistentSet[T]) Any(pred func(elem T) bool) bool {
+	return !s.None(pred)
+}
+
+// Every indicates whether pred returns true for all elements of s.
+// It returns true for an empty set.
+func (s PersistentSet[T]) Every(pred func(elem T) bool) bool {
+	return s.root.each(pred)
+}
+
+// None indicates whether pred returns false for all elements of s.
+// It returns true for an empty set.
+func (s PersistentSet[T]) None(pred func(elem T) bool) bool {
+	return s.root.each(func(e T) bool { return !pred(e) })
+}
+
+// Count returns the number of elements of s for which pred returns true.
+func (s PersistentSet[T]) Count(pred func(elem T) bool) int {
+	n := 0
+	s.root.each(func(e T) bool {
+		if pred(e) {
+			n++
+		}
+		return true
+	})
+	return n
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// See Set.MarshalJSON for the encoding.
+func (s PersistentSet[T]) MarshalJSON() ([]byte, error) {
+	return marshalElems(s.AsSortedSlice())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It replaces the set pointed to by s with a set of the decoded elements;
+// other copies of the set are not affected.
+func (s *PersistentSet[T]) UnmarshalJSON(data []byte) error {
+	var t Set[T]
+	if err := json.Unmarshal(data, &t); err != nil || t == nil {
+		return err
+	}
+	*s = NewPersistentSetFromSet(t)
+	return nil
+}
+
+// NewPersistentSet returns a new PersistentSet containing zero or more
+// elements.
+func NewPersistentSet[T Ordered | Complex](elems ...T) PersistentSet[T] {
+	return PersistentSet[T]{}.With(elems...)
+}
+
+// NewPersistentSetFromSet returns a new PersistentSet containing the elements
+// of s, which can be a Set or any of the generated set types, such as IntSet.
+func NewPersistentSetFromSet[S ~map[T]struct{}, T Ordered | Complex](s S) PersistentSet[T] {
+	r := PersistentSet[T]{}
+	for e := range s {
+		r = r.With(e)
+	}
+	return r
+}
+
+// Persistent sets of all basic types.
+type (
+	// PersistentComplex128Set represents a persistent set of complex128 elements.
+	PersistentComplex128Set = PersistentSet[complex128]
+	// PersistentComplex64Set represents a persistent set of complex64 elements.
+	PersistentComplex64Set = PersistentSet[complex64]
+	// PersistentFloat32Set represents a persistent set of float32 elements.
+	PersistentFloat32Set = PersistentSet[float32]
+	// PersistentFloat64Set represents a persistent set of float64 elements.
+	PersistentFloat64Set = PersistentSet[float64]
+	// PersistentIntSet represents a persistent set of int elements.
+	PersistentIntSet = PersistentSet[int]
+	// PersistentInt16Set represents a persistent set of int16 elements.
+	PersistentInt16Set = PersistentSet[int16]
+	// PersistentInt32Set represents a persistent set of int32 elements.
+	PersistentInt32Set = PersistentSet[int32]
+	// PersistentInt64Set represents a persistent set of int64 elements.
+	PersistentInt64Set = PersistentSet[int64]
+	// PersistentInt8Set represents a persistent set of int8 elements.
+	PersistentInt8Set = PersistentSet[int8]
+	// PersistentStringSet represents a persistent set of string elements.
+	PersistentStringSet = PersistentSet[string]
+	// PersistentUIntSet represents a persistent set of uint elements.
+	PersistentUIntSet = PersistentSet[uint]
+	// PersistentUInt16Set represents a persistent set of uint16 elements.
+	PersistentUInt16Set = PersistentSet[uint16]
+	// PersistentUInt32Set represents a persistent set of uint32 elements.
+	PersistentUInt32Set = PersistentSet[uint32]
+	// PersistentUInt64Set represents a persistent set of uint64 elements.
+	PersistentUInt64Set = PersistentSet[uint64]
+	// PersistentUInt8Set represents a persistent set of uint8 elements.
+	PersistentUInt8Set = PersistentSet[uint8]
+	// PersistentUIntPtrSet represents a persistent set of uintptr elements.
+	PersistentUIntPtrSet = PersistentSet[uintptr]
+)
+
+// NewPersistentComplex128Set returns a new PersistentComplex128Set containing zero or more elements.
+func NewPersistentComplex128Set(elems ...complex128) PersistentComplex128Set {
+	return NewPersistentSet(elems...)
+}
+
+// NewPersistentComplex64Set returns a new PersistentComplex64Set containing zero or more elements.
+func NewPersistentComplex64Set(elems ...complex64) PersistentComplex64Set {
+	return NewPersistentSet(elems...)
+}
+
+// NewPersistentFloat32Set returns a new PersistentFloat32Set containing zero or more elements.
+func NewPersistentFloat32Set(elems ...float32) PersistentFloat32Set {
+	return NewPersistentSet(elems...)
+}
+
+// NewPersistentFloat64Set returns a new PersistentFloat64Set containing zero or more elements.
+func NewPersistentFloat64Set(elems ...float64) PersistentFloat64Set {
+	return NewPersistentSet(elems...)
+}
+
+// NewPersistentIntSet returns a new PersistentIntSet containing zero or more elements.
+func NewPersistentIntSet(elems ...int) PersistentIntSet {
+	return NewPersistentSet(elems...)
+}
+
+// NewPersistentInt16Set returns a new PersistentInt16Set containing zero or more elements.
+func NewPersistentInt16Set(elems ...int16) PersistentInt16Set {
+	return NewPersistentSet(elems...)
+}
+
+// NewPersistentInt32Set returns a new PersistentInt32Set containing zero or more elements.
+func NewPersistentInt32Set(elems ...int32) PersistentInt32Set {
+	return NewPersistentSet(elems...)
+}
+
+// NewPersistentInt64Set returns a new PersistentInt64Set containing zero or more elements.
+func NewPersistentInt64Set(elems ...int64) PersistentInt64Set {
+	return NewPersistentSet(elems...)
+}
+
+// NewPersistentInt8Set returns a new PersistentInt8Set containing zero or more elements.
+func NewPersistentInt8Set(elems ...int8) PersistentInt8Set {
+	return NewPersistentSet(elems...)
+}
+
+// NewPersistentStringSet returns a new PersistentStringSet containing zero or more elements.
+func NewPersistentStringSet(elems ...string) PersistentStringSet {
+	return NewPersistentSet(elems...)
+}
+
+// NewPersistentUIntSet returns a new PersistentUIntSet containing zero or more elements.
+func NewPersistentUIntSet(elems ...uint) PersistentUIntSet {
+	return NewPersistentSet(elems...)
+}
+
+// NewPersistentUInt16Set returns a new PersistentUInt16Set containing zero or more elements.
+func NewPersistentUInt16Set(elems ...uint16) PersistentUInt16Set {
+	return NewPersistentSet(elems...)
+}
+
+// NewPersistentUInt32Set returns a new PersistentUInt32Set containing zero or more elements.
+func NewPersistentUInt32Set(elems ...uint32) PersistentUInt32Set {
+	return NewPersistentSet(elems...)
+}
+
+// NewPersistentUInt64Set returns a new PersistentUInt64Set containing zero or more elements.
+func NewPersistentUInt64Set(elems ...uint64) PersistentUInt64Set {
+	return NewPersistentSet(elems...)
+}
+
+// NewPersistentUInt8Set returns a new PersistentUInt8Set containing zero or more elements.
+func NewPersistentUInt8Set(elems ...uint8) PersistentUInt8Set {
+	return NewPersistentSet(elems...)
+}
+
+// NewPersistentUIntPtrSet returns a new PersistentUIntPtrSet containing zero or more elements.
+func NewPersistentUIntPtrSet(elems ...uintptr) PersistentUIntPtrSet {
+	return NewPersistentSet(elems...)
+}
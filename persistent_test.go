@@ -0,0 +1,142 @@
+package menge_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/soroushj/menge"
+)
+
+// TestPersistentSet compares the results of PersistentSet with those of Set.
+func TestPersistentSet(t *testing.T) {
+	sets := [][]int{{}, {1}, {2}, {1, 2}, {1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, {-1, 0, 1, 2, 1000, 1 << 40}}
+	for _, a := range sets {
+		for _, b := range sets {
+			s, u := menge.NewPersistentIntSet(a...), menge.NewPersistentIntSet(b...)
+			ms, mu := menge.NewSet(a...), menge.NewSet(b...)
+			if got, want := s.Equals(u), ms.Equals(mu); got != want {
+				t.Errorf("%v Equals %v got: %v", a, b, got)
+			}
+			for _, op := range []struct {
+				name string
+				f    func(s, u menge.PersistentIntSet) menge.PersistentIntSet
+				g    func(s, u menge.Set[int]) menge.Set[int]
+			}{
+				{"Union", menge.PersistentIntSet.Union, menge.Set[int].Union},
+				{"Intersection", menge.PersistentIntSet.Intersection, menge.Set[int].Intersection},
+				{"Difference", menge.PersistentIntSet.Difference, menge.Set[int].Difference},
+				{"SymmetricDifference", menge.PersistentIntSet.SymmetricDifference, menge.Set[int].SymmetricDifference},
+			} {
+				got, want := op.f(s, u), op.g(ms, mu)
+				if !reflect.DeepEqual(got.AsSortedSlice(), want.AsSortedSlice()) || got.Size() != want.Size() {
+					t.Errorf("%v %s %v got: %v", a, op.name, b, got)
+				}
+			}
+			for _, op := range []struct {
+				name string
+				f    func(s, u menge.PersistentIntSet) bool
+				g    func(s, u menge.Set[int]) bool
+			}{
+				{"IsSubsetOf", menge.PersistentIntSet.IsSubsetOf, menge.Set[int].IsSubsetOf},
+				{"IsProperSubsetOf", menge.PersistentIntSet.IsProperSubsetOf, menge.Set[int].IsProperSubsetOf},
+				{"IsSupersetOf", menge.PersistentIntSet.IsSupersetOf, menge.Set[int].IsSupersetOf},
+				{"IsProperSupersetOf", menge.PersistentIntSet.IsProperSupersetOf, menge.Set[int].IsProperSupersetOf},
+				{"IsDisjointFrom", menge.PersistentIntSet.IsDisjointFrom, menge.Set[int].IsDisjointFrom},
+			} {
+				if got, want := op.f(s, u), op.g(ms, mu); got != want {
+					t.Errorf("%v %s %v got: %v", a, op.name, b, got)
+				}
+			}
+			if got, want := s.UnionSize(u)+s.DifferenceSize(u), ms.UnionSize(mu)+ms.DifferenceSize(mu); got != want {
+				t.Errorf("%v sizes %v got: %v", a, b, got)
+			}
+			if got, want := s.Jaccard(u)+s.SorensenDice(u)+s.OverlapCoefficient(u)+s.CosineSimilarity(u),
+				ms.Jaccard(mu)+ms.SorensenDice(mu)+ms.OverlapCoefficient(mu)+ms.CosineSimilarity(mu); got != want {
+				t.Errorf("%v similarity %v got: %v", a, b, got)
+			}
+			if !reflect.DeepEqual(s.ToSet(), ms) || !reflect.DeepEqual(u.ToSet(), mu) {
+				t.Errorf("%v and %v were modified: %v %v", a, b, s, u)
+			}
+		}
+		s, ms := menge.NewPersistentIntSet(a...), menge.NewSet(a...)
+		if s.Size() != ms.Size() || s.IsEmpty() != ms.IsEmpty() || s.String() != ms.String() {
+			t.Errorf("%v got: %v", a, s)
+		}
+		if !reflect.DeepEqual(s.AsSortedSliceDesc(), ms.AsSortedSliceDesc()) || len(s.AppendTo([]int{0})) != len(a)+1 {
+			t.Errorf("%v slices got: %v", a, s)
+		}
+		odd := func(e int) bool { return e%2 != 0 }
+		in, out := s.Partition(odd)
+		if !reflect.DeepEqual(in.ToSet(), ms.Filter(odd)) || in.Size()+out.Size() != s.Size() || !s.Filter(odd).Equals(in) {
+			t.Errorf("%v Partition got: %v %v", a, in, out)
+		}
+		if s.Any(odd) != ms.Any(odd) || s.Every(odd) != ms.Every(odd) || s.None(odd) != ms.None(odd) || s.Count(odd) != ms.Count(odd) {
+			t.Errorf("%v predicates got: %v %v %v %v", a, s.Any(odd), s.Every(odd), s.None(odd), s.Count(odd))
+		}
+		if u := menge.NewPersistentSetFromSet(menge.IntSet(ms)); !u.Equals(s) {
+			t.Errorf("%v NewPersistentSetFromSet got: %v", a, u)
+		}
+	}
+}
+
+func TestPersistentSet_Versions(t *testing.T) {
+	var empty menge.PersistentStringSet
+	s := empty.With("a", "b")
+	u := s.With("c")
+	v := u.Without("a", "x")
+	if empty.Size() != 0 || s.String() != "{a b}" || u.String() != "{a b c}" || v.String() != "{b c}" {
+		t.Errorf("got: %v %v %v %v", empty, s, u, v)
+	}
+	if w := s.With("a"); !w.Equals(s) || w.Size() != 2 {
+		t.Errorf("With of existing element got: %v", w)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if !u.Has("c") || u.Union(v).Size() != 3 {
+				t.Errorf("concurrent read got: %v", u)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestPersistentSet_Types(t *testing.T) {
+	f := menge.NewPersistentFloat64Set(math.NaN(), math.Copysign(0, -1), 0, math.Inf(1))
+	if f.Size() != 2 || !f.Has(0) || f.Has(math.NaN()) {
+		t.Errorf("Float64 got: %v", f)
+	}
+	c := menge.NewPersistentComplex128Set(complex(1, 2), complex(2, 1), complex(1, 2))
+	if c.Size() != 2 || !c.Has(complex(2, 1)) || c.Has(complex(1, 1)) {
+		t.Errorf("Complex128 got: %v", c)
+	}
+	type celsius float32
+	n := menge.NewPersistentSet[celsius](-1.5, 20, 20)
+	if n.Size() != 2 || !n.Has(-1.5) || fmt.Sprint(n) != "{-1.5 20}" {
+		t.Errorf("named type got: %v", n)
+	}
+}
+
+func TestPersistentSet_Encoding(t *testing.T) {
+	s := menge.NewPersistentInt8Set(2, -1)
+	if got := fmt.Sprintf("%v %+v %#v", s, s, s); got != "{-1 2} {-1 2} (size 2) menge.NewPersistentSet[int8](-1, 2)" {
+		t.Errorf("Format got: %s", got)
+	}
+	data, err := json.Marshal(s)
+	if err != nil || string(data) != `[-1,2]` {
+		t.Errorf("MarshalJSON got: %s %v", data, err)
+	}
+	u := s
+	if err := json.Unmarshal([]byte(`[2,1,2]`), &u); err != nil || u.String() != "{1 2}" || s.String() != "{-1 2}" {
+		t.Errorf("UnmarshalJSON got: %v %v", u, err)
+	}
+	if err := json.Unmarshal([]byte(`[128]`), &u); err == nil {
+		t.Errorf("UnmarshalJSON of out of range element got: %v", u)
+	}
+}
@@ -0,0 +1,672 @@
+package menge
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// NaNPolicy decides what a FloatPolicy does with NaN values.
+// A complex number is NaN if either of its parts is, even if the other part
+// is infinite.
+type NaNPolicy uint8
+
+const (
+	// DropNaN ignores NaN values, as Float64Set does.
+	DropNaN NaNPolicy = iota
+	// RejectNaN rejects NaN values: TryAdd and UnmarshalJSON return an error
+	// wrapping ErrNaN for them, while Add ignores them.
+	RejectNaN
+	// CanonicalNaN stores all NaN values as a single element, the canonical
+	// NaN, which is math.NaN() for floats and complex(math.NaN(), math.NaN())
+	// for complex numbers, and which any NaN value is found as.
+	CanonicalNaN
+)
+
+// String returns the name of the policy, e.g., RejectNaN.
+func (p NaNPolicy) String() string {
+	switch p {
+	case DropNaN:
+		return "DropNaN"
+	case RejectNaN:
+		return "RejectNaN"
+	case CanonicalNaN:
+		return "CanonicalNaN"
+	}
+	return fmt.Sprintf("NaNPolicy(%d)", uint8(p))
+}
+
+// InfPolicy decides what a FloatPolicy does with infinite values.
+// A complex number is infinite if either of its parts is, and neither is NaN.
+type InfPolicy uint8
+
+const (
+	// KeepInf stores infinite values as any other, as Float64Set does.
+	KeepInf InfPolicy = iota
+	// DropInf ignores infinite values.
+	DropInf
+	// RejectInf rejects infinite values: TryAdd and UnmarshalJSON return an
+	// error wrapping ErrInf for them, while Add ignores them.
+	RejectInf
+)
+
+// String returns the name of the policy, e.g., RejectInf.
+func (p InfPolicy) String() string {
+	switch p {
+	case KeepInf:
+		return "KeepInf"
+	case DropInf:
+		return "DropInf"
+	case RejectInf:
+		return "RejectInf"
+	}
+	return fmt.Sprintf("InfPolicy(%d)", uint8(p))
+}
+
+// The errors of the values rejected by a FloatPolicy.
+// The errors returned by PolicySet wrap them, so they can be checked with
+// errors.Is.
+var (
+	ErrNaN = errors.New("menge: NaN value")
+	ErrInf = errors.New("menge: infinite value")
+)
+
+// errDropped is returned by FloatPolicy.key for a value that is dropped.
+var errDropped = errors.New("menge: dropped value")
+
+// FloatPolicy decides how a PolicySet treats the special values of
+// floating-point and complex numbers: NaN, -0 and infinities.
+// The zero value behaves as Float64Set does: NaN values are ignored,
+// infinities are kept, and -0 and +0 are the same element, stored as +0.
+type FloatPolicy struct {
+	// NaN decides what is done with NaN values.
+	NaN NaNPolicy
+	// Inf decides what is done with infinite values.
+	Inf InfPolicy
+	// SignedZeros makes -0 and +0 distinct elements. For complex numbers,
+	// it applies to each of their parts.
+	SignedZeros bool
+}
+
+// String returns a string representation of the policy,
+// e.g., FloatPolicy{NaN: RejectNaN, Inf: KeepInf, SignedZeros: true}.
+func (p FloatPolicy) String() string {
+	return p.format("")
+}
+
+// format returns a string representation of the policy with a prefix before
+// the type and constant names, e.g., "menge.".
+func (p FloatPolicy) format(prefix string) string {
+	return fmt.Sprintf("%sFloatPolicy{NaN: %s%v, Inf: %s%v, SignedZeros: %v}", prefix, prefix, p.NaN, prefix, p.Inf, p.SignedZeros)
+}
+
+// policyKey is the key of an element of a PolicySet: the bits of its real and
+// imaginary parts, as float64 values, after the policy is applied.
+type policyKey [2]uint64
+
+// nanKey is the key of the canonical NaN.
+var nanKey = policyKey{math.Float64bits(math.NaN()), math.Float64bits(math.NaN())}
+
+// key returns the key of e under the policy, and the element to store for it,
+// which is e or its canonical form. It returns errDropped if the policy
+// ignores e, and ErrNaN or ErrInf if it rejects e.
+func key[T Float | Complex](p FloatPolicy, e T) (policyKey, T, error) {
+	re, im, cplx := floatParts(e)
+	switch {
+	case math.IsNaN(re) || math.IsNaN(im):
+		switch p.NaN {
+		case RejectNaN:
+			return policyKey{}, e, ErrNaN
+		case CanonicalNaN:
+			if cplx {
+				return nanKey, fromFloatParts[T](math.NaN(), math.NaN()), nil
+			}
+			return nanKey, fromFloatParts[T](math.NaN(), 0), nil
+		}
+		return policyKey{}, e, errDropped
+	case math.IsInf(re, 0) || math.IsInf(im, 0):
+		switch p.Inf {
+		case DropInf:
+			return policyKey{}, e, errDropped
+		case RejectInf:
+			return policyKey{}, e, ErrInf
+		}
+	}
+	if !p.SignedZeros && (re == 0 && math.Signbit(re) || im == 0 && math.Signbit(im)) {
+		if re == 0 {
+			re = 0
+		}
+		if im == 0 {
+			im = 0
+		}
+		e = fromFloatParts[T](re, im)
+	}
+	return policyKey{math.Float64bits(re), math.Float64bits(im)}, e, nil
+}
+
+// floatParts returns the real and imaginary parts of e as float64 values,
+// which are exact, and whether e is a complex number.
+func floatParts[T Float | Complex](e T) (re, im float64, cplx bool) {
+	switch e := any(e).(type) {
+	case float64:
+		return e, 0, false
+	case float32:
+		return float64(e), 0, false
+	case complex128:
+		return real(e), imag(e), true
+	case complex64:
+		return float64(real(e)), float64(imag(e)), true
+	}
+	// Named types are converted by kind.
+	v := reflect.ValueOf(e)
+	if k := v.Kind(); k == reflect.Complex64 || k == reflect.Complex128 {
+		c := v.Complex()
+		return real(c), imag(c), true
+	}
+	return v.Float(), 0, false
+}
+
+// fromFloatParts returns the value of type T with the real and imaginary
+// parts re and im. The imaginary part is ignored if T is a float type.
+func fromFloatParts[T Float | Complex](re, im float64) T {
+	var e T
+	v := reflect.ValueOf(&e).Elem()
+	if k := v.Kind(); k == reflect.Complex64 || k == reflect.Complex128 {
+		v.SetComplex(complex(re, im))
+	} else {
+		v.SetFloat(re)
+	}
+	return e
+}
+
+// PolicySet represents a set of floating-point or complex numbers that
+// treats NaN, -0 and infinities according to a FloatPolicy.
+// Unlike Float64Set, it can store NaN, as a single canonical element, and
+// keep -0 and +0 apart, as its elements are compared by their bits after the
+// policy is applied. Add ignores the elements rejected by the policy, and
+// TryAdd reports them with an error.
+// The binary set operations compare the elements of t by their keys in t,
+// so s and t are expected to have the same policy.
+// The zero value is an empty set ready to use, with the zero FloatPolicy.
+type PolicySet[T Float | Complex] struct {
+	policy FloatPolicy
+	m      map[policyKey]T
+}
+
+// empty returns an empty set with the same policy as s.
+func (s *PolicySet[T]) empty(size int) *PolicySet[T] {
+	return &PolicySet[T]{s.policy, make(map[policyKey]T, size)}
+}
+
+// Policy returns the policy of the set.
+func (s *PolicySet[T]) Policy() FloatPolicy {
+	return s.policy
+}
+
+// Add adds zero or more elements to the set.
+// Elements dropped or rejected by the policy are ignored; TryAdd is used to
+// detect the rejected ones.
+func (s *PolicySet[T]) Add(elems ...T) {
+	if s.m == nil {
+		s.m = make(map[policyKey]T, len(elems))
+	}
+	for _, e := range elems {
+		if k, x, err := key(s.policy, e); err == nil {
+			s.m[k] = x
+		}
+	}
+}
+
+// TryAdd adds zero or more elements to the set, like Add, unless the policy
+// rejects any of them. Then it adds none of them and returns an error
+// wrapping ErrNaN or ErrInf.
+func (s *PolicySet[T]) TryAdd(elems ...T) error {
+	for i, e := range elems {
+		if _, _, err := key(s.policy, e); err != nil && err != errDropped {
+			return fmt.Errorf("%w: element %d: %v", err, i, e)
+		}
+	}
+	s.Add(elems...)
+	return nil
+}
+
+// Remove removes zero or more elements from the set.
+// Elements dropped or rejected by the policy are ignored.
+func (s *PolicySet[T]) Remove(elems ...T) {
+	for _, e := range elems {
+		if k, _, err := key(s.policy, e); err == nil {
+			delete(s.m, k)
+		}
+	}
+}
+
+// Empty empties the set.
+func (s *PolicySet[T]) Empty() {
+	for k := range s.m {
+		delete(s.m, k)
+	}
+}
+
+// Has indicates whether the set has an element. It returns false for the
+// elements dropped or rejected by the policy.
+func (s *PolicySet[T]) Has(elem T) bool {
+	k, _, err := key(s.policy, elem)
+	if err != nil {
+		return false
+	}
+	_, ok := s.m[k]
+	return ok
+}
+
+// Size returns the size of the set.
+func (s *PolicySet[T]) Size() int {
+	return len(s.m)
+}
+
+// IsEmpty indicates whether the set is empty.
+func (s *PolicySet[T]) IsEmpty() bool {
+	return len(s.m) == 0
+}
+
+// Clone returns a clone of the set.
+func (s *PolicySet[T]) Clone() *PolicySet[T] {
+	r := s.empty(len(s.m))
+	for k, e := range s.m {
+		r.m[k] = e
+	}
+	return r
+}
+
+// AsSlice returns an equivalent slice with no specific order of the elements.
+func (s *PolicySet[T]) AsSlice() []T {
+	return s.AppendTo(make([]T, 0, len(s.m)))
+}
+
+// AsSortedSlice returns an equivalent slice with the elements in their
+// natural order. See LessFunc for the definition of the order.
+func (s *PolicySet[T]) AsSortedSlice() []T {
+	a := s.AsSlice()
+	sortElems(a)
+	return a
+}
+
+// AsSortedSliceDesc returns an equivalent slice with the elements in the
+// reverse of their natural order.
+func (s *PolicySet[T]) AsSortedSliceDesc() []T {
+	a := s.AsSortedSlice()
+	for i, j := 0, len(a)-1; i < j; i, j = i+1, j-1 {
+		a[i], a[j] = a[j], a[i]
+	}
+	return a
+}
+
+// AppendTo appends the elements of the set to dst, with no specific order,
+// and returns the extended slice. It allocates only if dst does not have
+// enough capacity.
+func (s *PolicySet[T]) AppendTo(dst []T) []T {
+	for _, e := range s.m {
+		dst = append(dst, e)
+	}
+	return dst
+}
+
+// ToSet returns a Set of the elements of the set, which can be converted to
+// the corresponding generated set type at no cost, e.g.,
+// Float64Set(s.ToSet()). A canonical NaN element cannot be found in the
+// result, and a -0 element is the same element as +0 in it.
+func (s *PolicySet[T]) ToSet() Set[T] {
+	r := make(Set[T], len(s.m))
+	for _, e := range s.m {
+		r[e] = struct{}{}
+	}
+	return r
+}
+
+// String returns a string representation of the set,
+// with its elements in their natural order.
+func (s *PolicySet[T]) String() string {
+	b := &strings.Builder{}
+	writeElems(b, s.AsSortedSlice(), "%v", " ")
+	return b.String()
+}
+
+// Format implements the fmt.Formatter interface.
+// See Set.Format for the supported verbs. The %#v verb formats the set as
+// a call to NewPolicySet, with its FloatPolicy.
+func (s *PolicySet[T]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		var zero T
+		fmt.Fprintf(f, "menge.NewPolicySet[%s](%s", reflect.TypeOf(zero).String(), s.policy.format("menge."))
+		for _, e := range s.AsSortedSlice() {
+			io.WriteString(f, ", "+goSyntax(e))
+		}
+		io.WriteString(f, ")")
+		return
+	}
+	formatElems(f, verb, s.AsSortedSlice(), "")
+}
+
+// Equals indicates whether s and t have the same elements.
+func (s *PolicySet[T]) Equals(t *PolicySet[T]) bool {
+	return len(s.m) == len(t.m) && s.IsSubsetOf(t)
+}
+
+// Union returns the union of s and t.
+func (s *PolicySet[T]) Union(t *PolicySet[T]) *PolicySet[T] {
+	r := s.Clone()
+	r.UnionWith(t)
+	return r
+}
+
+// Intersection returns the intersection of s and t.
+func (s *PolicySet[T]) Intersection(t *PolicySet[T]) *PolicySet[T] {
+	r := s.empty(0)
+	for k, e := range s.m {
+		if _, ok := t.m[k]; ok {
+			r.m[k] = e
+		}
+	}
+	return r
+}
+
+// Difference returns the difference of s and t, i.e., s - t.
+func (s *PolicySet[T]) Difference(t *PolicySet[T]) *PolicySet[T] {
+	r := s.empty(0)
+	for k, e := range s.m {
+		if _, ok := t.m[k]; !ok {
+			r.m[k] = e
+		}
+	}
+	return r
+}
+
+// SymmetricDifference returns the symmetric difference of s and t,
+// i.e., (s - t) ⋃ (t - s).
+func (s *PolicySet[T]) SymmetricDifference(t *PolicySet[T]) *PolicySet[T] {
+	r := s.Difference(t)
+	for k, e := range t.m {
+		if _, ok := s.m[k]; !ok {
+			r.m[k] = e
+		}
+	}
+	return r
+}
+
+// UnionWith adds the elements of t to s, i.e., s = s ⋃ t.
+func (s *PolicySet[T]) UnionWith(t *PolicySet[T]) {
+	if s.m == nil {
+		s.m = make(map[policyKey]T, len(t.m))
+	}
+	for k, e := range t.m {
+		s.m[k] = e
+	}
+}
+
+// IntersectWith removes the elements of s that are not in t, i.e., s = s ⋂ t.
+func (s *PolicySet[T]) IntersectWith(t *PolicySet[T]) {
+	for k := range s.m {
+		if _, ok := t.m[k]; !ok {
+			delete(s.m, k)
+		}
+	}
+}
+
+// DifferenceWith removes the elements of t from s, i.e., s = s - t.
+func (s *PolicySet[T]) DifferenceWith(t *PolicySet[T]) {
+	if s == t {
+		s.Empty()
+		return
+	}
+	for k := range t.m {
+		delete(s.m, k)
+	}
+}
+
+// SymmetricDifferenceWith replaces s with the symmetric difference of s and t,
+// i.e., s = (s - t) ⋃ (t - s).
+func (s *PolicySet[T]) SymmetricDifferenceWith(t *PolicySet[T]) {
+	*s = *s.SymmetricDifference(t)
+}
+
+// IsSubsetOf indicates whether s is a subset of t.
+func (s *PolicySet[T]) IsSubsetOf(t *PolicySet[T]) bool {
+	if len(s.m) > len(t.m) {
+		return false
+	}
+	for k := range s.m {
+		if _, ok := t.m[k]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// IsProperSubsetOf indicates whether s is a proper subset of t.
+func (s *PolicySet[T]) IsProperSubsetOf(t *PolicySet[T]) bool {
+	return len(s.m) < len(t.m) && s.IsSubsetOf(t)
+}
+
+// IsSupersetOf indicates whether s is a superset of t.
+func (s *PolicySet[T]) IsSupersetOf(t *PolicySet[T]) bool {
+	return t.IsSubsetOf(s)
+}
+
+// IsProperSupersetOf indicates whether s is a proper superset of t.
+func (s *PolicySet[T]) IsProperSupersetOf(t *PolicySet[T]) bool {
+	return t.IsProperSubsetOf(s)
+}
+
+// IsDisjointFrom indicates whether s and t are disjoint.
+func (s *PolicySet[T]) IsDisjointFrom(t *PolicySet[T]) bool {
+	return s.IntersectionSize(t) == 0
+}
+
+// IntersectionSize returns the size of the intersection of s and t,
+// without computing the intersection.
+func (s *PolicySet[T]) IntersectionSize(t *PolicySet[T]) int {
+	if len(s.m) > len(t.m) {
+		s, t = t, s
+	}
+	n := 0
+	for k := range s.m {
+		if _, ok := t.m[k]; ok {
+			n++
+		}
+	}
+	return n
+}
+
+// UnionSize returns the size of the union of s and t,
+// without computing the union.
+func (s *PolicySet[T]) UnionSize(t *PolicySet[T]) int {
+	return len(s.m) + len(t.m) - s.IntersectionSize(t)
+}
+
+// DifferenceSize returns the size of the difference of s and t, i.e., s - t,
+// without computing the difference.
+func (s *PolicySet[T]) DifferenceSize(t *PolicySet[T]) int {
+	return len(s.m) - s.IntersectionSize(t)
+}
+
+// Jaccard returns the Jaccard index of s and t, i.e., |s ⋂ t| / |s ⋃ t|.
+// The Jaccard index of two empty sets is 1.
+func (s *PolicySet[T]) Jaccard(t *PolicySet[T]) float64 {
+	return jaccard(s.IntersectionSize(t), len(s.m), len(t.m))
+}
+
+// SorensenDice returns the Sørensen–Dice coefficient of s and t,
+// i.e., 2|s ⋂ t| / (|s| + |t|).
+// The Sørensen–Dice coefficient of two empty sets is 1.
+func (s *PolicySet[T]) SorensenDice(t *PolicySet[T]) float64 {
+	return sorensenDice(s.IntersectionSize(t), len(s.m), len(t.m))
+}
+
+// OverlapCoefficient returns the overlap coefficient of s and t,
+// i.e., |s ⋂ t| / min(|s|, |t|).
+// The overlap coefficient of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s *PolicySet[T]) OverlapCoefficient(t *PolicySet[T]) float64 {
+	return overlapCoefficient(s.IntersectionSize(t), len(s.m), len(t.m))
+}
+
+// CosineSimilarity returns the cosine similarity of s and t,
+// i.e., |s ⋂ t| / √(|s||t|).
+// The cosine similarity of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s *PolicySet[T]) CosineSimilarity(t *PolicySet[T]) float64 {
+	return cosineSimilarity(s.IntersectionSize(t), len(s.m), len(t.m))
+}
+
+// Filter returns a new set containing the elements of s for which pred
+// returns true.
+func (s *PolicySet[T]) Filter(pred func(elem T) bool) *PolicySet[T] {
+	r := s.empty(0)
+	for k, e := range s.m {
+		if pred(e) {
+			r.m[k] = e
+		}
+	}
+	return r
+}
+
+// Retain removes the elements of s for which pred returns false.
+func (s *PolicySet[T]) Retain(pred func(elem T) bool) {
+	for k, e := range s.m {
+		if !pred(e) {
+			delete(s.m, k)
+		}
+	}
+}
+
+// RemoveIf removes the elements of s for which pred returns true.
+func (s *PolicySet[T]) RemoveIf(pred func(elem T) bool) {
+	for k, e := range s.m {
+		if pred(e) {
+			delete(s.m, k)
+		}
+	}
+}
+
+// Partition returns two new sets containing the elements of s for which pred
+// returns true and false, respectively.
+func (s *PolicySet[T]) Partition(pred func(elem T) bool) (in, out *PolicySet[T]) {
+	in, out = s.empty(0), s.empty(0)
+	for k, e := range s.m {
+		if pred(e) {
+			in.m[k] = e
+		} else {
+			out.m[k] = e
+		}
+	}
+	return in, out
+}
+
+// Any indicates whether pred returns true for any element of s.
+// It returns false for an empty set.
+func (s *PolicySet[T]) Any(pred func(elem T) bool) bool {
+	for _, e := range s.m {
+		if pred(e) {
+			return true
+		}
+	}
+	return false
+}
+
+// Every indicates whether pred returns true for all elements of s.
+// It returns true for an empty set.
+func (s *PolicySet[T]) Every(pred func(elem T) bool) bool {
+	for _, e := range s.m {
+		if !pred(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// None indicates whether pred returns false for all elements of s.
+// It returns true for an empty set.
+func (s *PolicySet[T]) None(pred func(elem T) bool) bool {
+	return !s.Any(pred)
+}
+
+// Count returns the number of elements of s for which pred returns true.
+func (s *PolicySet[T]) Count(pred func(elem T) bool) int {
+	n := 0
+	for _, e := range s.m {
+		if pred(e) {
+			n++
+		}
+	}
+	return n
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// See Set.MarshalJSON for the encoding.
+func (s *PolicySet[T]) MarshalJSON() ([]byte, error) {
+	return marshalElems(s.AsSortedSlice())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It replaces the elements of the set with the decoded ones and keeps the
+// policy of the set. If the policy rejects an element, it returns an error
+// wrapping ErrNaN or ErrInf and leaves the set unchanged.
+func (s *PolicySet[T]) UnmarshalJSON(data []byte) error {
+	a, err := unmarshalElems[T](data)
+	if err != nil || a == nil {
+		return err
+	}
+	t := &PolicySet[T]{policy: s.policy}
+	if err := t.TryAdd(a...); err != nil {
+		return err
+	}
+	s.m = t.m
+	return nil
+}
+
+// NewPolicySet returns a new PolicySet with a policy, containing zero or
+// more elements. Like Add, it ignores the elements dropped or rejected by the
+// policy.
+func NewPolicySet[T Float | Complex](p FloatPolicy, elems ...T) *PolicySet[T] {
+	s := &PolicySet[T]{policy: p}
+	s.Add(elems...)
+	return s
+}
+
+// Policy sets of all floating-point and complex types.
+type (
+	// PolicyFloat32Set represents a policy set of float32 elements.
+	PolicyFloat32Set = PolicySet[float32]
+	// PolicyFloat64Set represents a policy set of float64 elements.
+	PolicyFloat64Set = PolicySet[float64]
+	// PolicyComplex64Set represents a policy set of complex64 elements.
+	PolicyComplex64Set = PolicySet[complex64]
+	// PolicyComplex128Set represents a policy set of complex128 elements.
+	PolicyComplex128Set = PolicySet[complex128]
+)
+
+// NewPolicyFloat32Set returns a new PolicyFloat32Set with a policy,
+// containing zero or more elements.
+func NewPolicyFloat32Set(p FloatPolicy, elems ...float32) *PolicyFloat32Set {
+	return NewPolicySet(p, elems...)
+}
+
+// NewPolicyFloat64Set returns a new PolicyFloat64Set with a policy,
+// containing zero or more elements.
+func NewPolicyFloat64Set(p FloatPolicy, elems ...float64) *PolicyFloat64Set {
+	return NewPolicySet(p, elems...)
+}
+
+// NewPolicyComplex64Set returns a new PolicyComplex64Set with a policy,
+// containing zero or more elements.
+func NewPolicyComplex64Set(p FloatPolicy, elems ...complex64) *PolicyComplex64Set {
+	return NewPolicySet(p, elems...)
+}
+
+// NewPolicyComplex128Set returns a new PolicyComplex128Set with a policy,
+// containing zero or more elements.
+func NewPolicyComplex128Set(p FloatPolicy, elems ...complex128) *PolicyComplex128Set {
+	return NewPolicySet(p, elems...)
+}
@@ -0,0 +1,211 @@
+package menge_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/soroushj/menge"
+)
+
+var negZero = math.Copysign(0, -1)
+
+// specials are the special values of a type: NaN values, infinite values,
+// zeros of different signs with +0 last, and a plain number.
+type specials[T menge.Float | menge.Complex] struct {
+	nans, infs, zeros []T
+	plain             T
+}
+
+func TestPolicySet_Float64(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+	testPolicies(t, specials[float64]{
+		nans:  []float64{nan, math.Copysign(nan, -1)},
+		infs:  []float64{inf, -inf},
+		zeros: []float64{negZero, 0},
+		plain: 1,
+	})
+}
+
+func TestPolicySet_Float32(t *testing.T) {
+	nan, inf := float32(math.NaN()), float32(math.Inf(1))
+	testPolicies(t, specials[float32]{
+		nans:  []float32{nan, -nan},
+		infs:  []float32{inf, -inf},
+		zeros: []float32{float32(negZero), 0},
+		plain: 1,
+	})
+}
+
+func TestPolicySet_Complex128(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+	testPolicies(t, specials[complex128]{
+		nans:  []complex128{complex(nan, 1), complex(1, nan), complex(inf, nan)},
+		infs:  []complex128{complex(inf, 1), complex(1, -inf)},
+		zeros: []complex128{complex(negZero, 0), complex(0, negZero), complex(negZero, negZero), 0},
+		plain: 1 + 1i,
+	})
+}
+
+func TestPolicySet_Complex64(t *testing.T) {
+	nan, inf, z := float32(math.NaN()), float32(math.Inf(1)), float32(negZero)
+	testPolicies(t, specials[complex64]{
+		nans:  []complex64{complex(nan, 1), complex(1, nan), complex(inf, nan)},
+		infs:  []complex64{complex(inf, 1), complex(1, -inf)},
+		zeros: []complex64{complex(z, 0), complex(0, z), complex(z, z), 0},
+		plain: 1 + 1i,
+	})
+}
+
+// testPolicies tests PolicySet[T] with every combination of the policies.
+func testPolicies[T menge.Float | menge.Complex](t *testing.T, v specials[T]) {
+	for _, nan := range []menge.NaNPolicy{menge.DropNaN, menge.RejectNaN, menge.CanonicalNaN} {
+		for _, inf := range []menge.InfPolicy{menge.KeepInf, menge.DropInf, menge.RejectInf} {
+			for _, signed := range []bool{false, true} {
+				p := menge.FloatPolicy{NaN: nan, Inf: inf, SignedZeros: signed}
+				t.Run(p.String(), func(t *testing.T) {
+					testPolicy(t, p, v)
+				})
+			}
+		}
+	}
+}
+
+func testPolicy[T menge.Float | menge.Complex](t *testing.T, p menge.FloatPolicy, v specials[T]) {
+	all := append(append(append([]T{v.plain}, v.nans...), v.infs...), v.zeros...)
+	s := menge.NewPolicySet[T](p)
+	err := s.TryAdd(all...)
+	switch {
+	case p.NaN == menge.RejectNaN:
+		if !errors.Is(err, menge.ErrNaN) {
+			t.Errorf("TryAdd got: %v, want ErrNaN", err)
+		}
+	case p.Inf == menge.RejectInf:
+		if !errors.Is(err, menge.ErrInf) {
+			t.Errorf("TryAdd got: %v, want ErrInf", err)
+		}
+	case err != nil:
+		t.Errorf("TryAdd got: %v", err)
+	}
+	if err != nil && (!s.IsEmpty() || !strings.HasPrefix(err.Error(), "menge: ")) {
+		t.Errorf("TryAdd with an error added: %v, error: %v", s, err)
+	}
+	want := 1
+	if p.NaN == menge.CanonicalNaN {
+		want++
+	}
+	if p.NaN != menge.RejectNaN {
+		s.Add(v.nans...)
+	}
+	if p.Inf == menge.KeepInf {
+		want += len(v.infs)
+	}
+	if p.Inf != menge.RejectInf {
+		s.Add(v.infs...)
+	}
+	if p.SignedZeros {
+		want += len(v.zeros)
+	} else {
+		want++
+	}
+	s.Add(v.zeros...)
+	s.Add(v.plain)
+	if s.Size() != want {
+		t.Errorf("got: %v, want size: %d", s, want)
+	}
+	for _, e := range v.nans {
+		if s.Has(e) != (p.NaN == menge.CanonicalNaN) {
+			t.Errorf("Has(%v) got: %v", e, s.Has(e))
+		}
+	}
+	for _, e := range v.infs {
+		if s.Has(e) != (p.Inf == menge.KeepInf) {
+			t.Errorf("Has(%v) got: %v", e, s.Has(e))
+		}
+	}
+	if got := menge.NewPolicySet(p, v.zeros[len(v.zeros)-1]); got.Has(v.zeros[0]) == p.SignedZeros {
+		t.Errorf("Has(%v) in %v got: %v", v.zeros[0], got, got.Has(v.zeros[0]))
+	}
+	if got := menge.NewPolicySet(p, v.zeros[0]).AsSlice()[0]; (fmt.Sprint(got) == fmt.Sprint(v.zeros[0])) != p.SignedZeros {
+		t.Errorf("zero stored as: %v", got)
+	}
+	s.Remove(v.nans[len(v.nans)-1])
+	s.Remove(v.infs...)
+	s.Remove(v.zeros[0])
+	if want = 1; p.SignedZeros {
+		want = len(v.zeros)
+	}
+	if s.Size() != want {
+		t.Errorf("Remove got: %v, want size: %d", s, want)
+	}
+	r := menge.NewPolicySet(p, v.plain, v.nans[0], v.infs[0])
+	if want = 3; p.NaN != menge.CanonicalNaN {
+		want--
+	}
+	if p.Inf != menge.KeepInf {
+		want--
+	}
+	if r.Size() != want {
+		t.Errorf("Add of special values got: %v, want size: %d", r, want)
+	}
+}
+
+func TestPolicySet(t *testing.T) {
+	p := menge.FloatPolicy{NaN: menge.CanonicalNaN, SignedZeros: true}
+	s := menge.NewPolicyFloat64Set(p, 1, math.NaN(), negZero, 0, math.Inf(-1), math.Copysign(math.NaN(), -1))
+	if s.Size() != 5 || s.String() != "{NaN -Inf -0 0 1}" || s.Policy() != p {
+		t.Errorf("got: %v", s)
+	}
+	u := menge.NewPolicyFloat64Set(p, math.NaN(), 0, 2)
+	for _, tc := range []struct {
+		name string
+		got  *menge.PolicyFloat64Set
+		want string
+	}{
+		{"Union", s.Union(u), "{NaN -Inf -0 0 1 2}"},
+		{"Intersection", s.Intersection(u), "{NaN 0}"},
+		{"Difference", s.Difference(u), "{-Inf -0 1}"},
+		{"SymmetricDifference", s.SymmetricDifference(u), "{-Inf -0 1 2}"},
+	} {
+		if tc.got.String() != tc.want || tc.got.Policy() != p {
+			t.Errorf("%s got: %v, want: %s", tc.name, tc.got, tc.want)
+		}
+	}
+	if !s.Intersection(u).IsProperSubsetOf(u) || s.IsDisjointFrom(u) || s.IntersectionSize(u) != 2 || s.UnionSize(u) != 6 {
+		t.Errorf("relations got different results")
+	}
+	c := s.Clone()
+	c.DifferenceWith(u)
+	c.UnionWith(menge.NewPolicyFloat64Set(p, math.NaN()))
+	if c.String() != "{NaN -Inf -0 1}" || s.Size() != 5 {
+		t.Errorf("mutations got: %v", c)
+	}
+	if got := menge.Float64Set(s.ToSet()); got.Size() != 4 || !got.Has(0) {
+		t.Errorf("ToSet got: %v", got)
+	}
+	z := menge.NewPolicyComplex128Set(menge.FloatPolicy{NaN: menge.CanonicalNaN}, complex(1, math.NaN()), complex(negZero, 1))
+	if got := z.String(); got != "{(NaN+NaNi) (0+1i)}" {
+		t.Errorf("complex got: %s", got)
+	}
+}
+
+func TestPolicySet_Encoding(t *testing.T) {
+	p := menge.FloatPolicy{NaN: menge.CanonicalNaN, Inf: menge.RejectInf}
+	s := menge.NewPolicyFloat64Set(p, 2, math.NaN())
+	if got := fmt.Sprintf("%v %+v %#v", s, s, s); got != "{NaN 2} {NaN 2} (size 2) menge.NewPolicySet[float64](menge.FloatPolicy{NaN: menge.CanonicalNaN, Inf: menge.RejectInf, SignedZeros: false}, math.NaN(), 2)" {
+		t.Errorf("Format got: %s", got)
+	}
+	data, err := json.Marshal(s)
+	if err != nil || string(data) != `["NaN",2]` {
+		t.Errorf("MarshalJSON got: %s %v", data, err)
+	}
+	if err := json.Unmarshal([]byte(`[1,"NaN","NaN"]`), s); err != nil || s.String() != "{NaN 1}" || s.Policy() != p {
+		t.Errorf("UnmarshalJSON got: %v %v", s, err)
+	}
+	if err := json.Unmarshal([]byte(`[3,"+Inf"]`), s); !errors.Is(err, menge.ErrInf) || s.String() != "{NaN 1}" {
+		t.Errorf("UnmarshalJSON of a rejected element got: %v %v", s, err)
+	}
+}
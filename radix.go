@@ -0,0 +1,191 @@
+package menge
+
+import (
+	"sort"
+	"strings"
+)
+
+// radix is a node of a radix tree, i.e., a trie in which a node that does
+// not end an element and has a single child is merged with the child.
+// The label of a node is the part of the elements below it that follows the
+// label of its parent; the label of the root is empty, and those of the other
+// nodes are not. The children are sorted by the first bytes of their labels,
+// which are distinct, so that the elements are visited in ascending order.
+type radix struct {
+	label    string
+	term     bool
+	children []*radix
+}
+
+// child returns the index of the child whose label starts with c, or the
+// index at which such a child would be inserted, and whether it exists.
+func (n *radix) child(c byte) (int, bool) {
+	i := sort.Search(len(n.children), func(i int) bool { return n.children[i].label[0] >= c })
+	return i, i < len(n.children) && n.children[i].label[0] == c
+}
+
+// commonPrefix returns the length of the longest common prefix of a and b.
+func commonPrefix(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
+
+// has indicates whether key, which follows the label of n, is below n.
+func (n *radix) has(key string) bool {
+	for key != "" {
+		i, ok := n.child(key[0])
+		if !ok || !strings.HasPrefix(key, n.children[i].label) {
+			return false
+		}
+		n = n.children[i]
+		key = key[len(n.label):]
+	}
+	return n.term
+}
+
+// insert adds key, which follows the label of n, below n, and reports
+// whether it was added.
+func (n *radix) insert(key string) bool {
+	for key != "" {
+		i, ok := n.child(key[0])
+		if !ok {
+			n.children = insertAt(n.children, i, &radix{label: key, term: true})
+			return true
+		}
+		c := n.children[i]
+		if l := commonPrefix(c.label, key); l < len(c.label) {
+			m := &radix{label: c.label[:l], children: []*radix{c}}
+			c.label = c.label[l:]
+			n.children[i] = m
+			c = m
+		}
+		n = c
+		key = key[len(c.label):]
+	}
+	added := !n.term
+	n.term = true
+	return added
+}
+
+// remove removes key, which follows the label of n, from below n, and reports
+// whether it was removed. It merges the nodes that no longer need to be
+// separate, except n itself, which is merged by its parent.
+func (n *radix) remove(key string) bool {
+	if key == "" {
+		removed := n.term
+		n.term = false
+		return removed
+	}
+	i, ok := n.child(key[0])
+	if !ok {
+		return false
+	}
+	c := n.children[i]
+	if !strings.HasPrefix(key, c.label) || !c.remove(key[len(c.label):]) {
+		return false
+	}
+	if !c.term {
+		switch len(c.children) {
+		case 0:
+			n.children = removeAt(n.children, i)
+		case 1:
+			g := c.children[0]
+			g.label = c.label + g.label
+			n.children[i] = g
+		}
+	}
+	return true
+}
+
+// seek returns the node below which are the elements that start with prefix,
+// which follows the label of n, and the part of those elements that precedes
+// the label of the node. It returns nil if there are no such elements.
+func (n *radix) seek(prefix string) (*radix, string) {
+	whole := prefix
+	for prefix != "" {
+		i, ok := n.child(prefix[0])
+		if !ok {
+			return nil, ""
+		}
+		c := n.children[i]
+		base := whole[:len(whole)-len(prefix)]
+		if len(prefix) <= len(c.label) {
+			if !strings.HasPrefix(c.label, prefix) {
+				return nil, ""
+			}
+			return c, base
+		}
+		if !strings.HasPrefix(prefix, c.label) {
+			return nil, ""
+		}
+		n = c
+		prefix = prefix[len(c.label):]
+	}
+	if !n.term && len(n.children) == 0 {
+		// Only the root of an empty tree has neither.
+		return nil, ""
+	}
+	return n, whole[:len(whole)-len(n.label)]
+}
+
+// longestPrefixOf returns the length of the longest element below n that is
+// a prefix of key, which follows the label of n, or -1 if there is none.
+func (n *radix) longestPrefixOf(key string) int {
+	longest, l := -1, 0
+	for {
+		if n.term {
+			longest = l
+		}
+		if l == len(key) {
+			return longest
+		}
+		i, ok := n.child(key[l])
+		if !ok || !strings.HasPrefix(key[l:], n.children[i].label) {
+			return longest
+		}
+		n = n.children[i]
+		l += len(n.label)
+	}
+}
+
+// ascend calls f for each element below n in ascending order, until f returns
+// false, and reports whether f never returned false. buf holds the part of
+// the elements that precedes the label of n.
+func (n *radix) ascend(buf []byte, f func(string) bool) bool {
+	buf = append(buf, n.label...)
+	if n.term && !f(string(buf)) {
+		return false
+	}
+	for _, c := range n.children {
+		if !c.ascend(buf, f) {
+			return false
+		}
+	}
+	return true
+}
+
+// descend is like ascend, but in descending order.
+func (n *radix) descend(buf []byte, f func(string) bool) bool {
+	buf = append(buf, n.label...)
+	for i := len(n.children) - 1; i >= 0; i-- {
+		if !n.children[i].descend(buf, f) {
+			return false
+		}
+	}
+	return !n.term || f(string(buf))
+}
+
+// clone returns a deep copy of n.
+func (n *radix) clone() *radix {
+	c := &radix{label: n.label, term: n.term}
+	if n.children != nil {
+		c.children = make([]*radix, len(n.children))
+		for i, d := range n.children {
+			c.children[i] = d.clone()
+		}
+	}
+	return c
+}
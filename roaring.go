@@ -0,0 +1,756 @@
+package menge
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/bits"
+	"sort"
+	"strings"
+)
+
+// RoaringUInt32Set represents a set of uint32 elements as a compressed
+// roaring bitmap, which takes a few bytes per element or less for clustered
+// elements. The elements are partitioned by their high 16 bits into
+// containers that hold their low 16 bits as a sorted array, a bitmap, or, after
+// Optimize, runs of consecutive elements.
+// The binary set operations combine the containers of the sets directly.
+// MarshalBinary and UnmarshalBinary use the portable format of the Roaring
+// format specification, shared by the roaring libraries of other languages.
+// The zero value is an empty set ready to use.
+type RoaringUInt32Set struct {
+	keys       []uint16
+	containers []*container
+}
+
+// find returns the index of the container with the high bits hi, or the index
+// where it would be inserted, and whether it exists.
+func (s *RoaringUInt32Set) find(hi uint16) (int, bool) {
+	i := sort.Search(len(s.keys), func(i int) bool { return s.keys[i] >= hi })
+	return i, i < len(s.keys) && s.keys[i] == hi
+}
+
+// Add adds zero or more elements to the set.
+func (s *RoaringUInt32Set) Add(elems ...uint32) {
+	for _, e := range elems {
+		hi, lo := uint16(e>>16), uint16(e)
+		i, ok := s.find(hi)
+		if !ok {
+			s.keys = append(s.keys, 0)
+			copy(s.keys[i+1:], s.keys[i:])
+			s.keys[i] = hi
+			s.containers = append(s.containers, nil)
+			copy(s.containers[i+1:], s.containers[i:])
+			s.containers[i] = &container{}
+		}
+		s.containers[i].add(lo)
+	}
+}
+
+// Remove removes zero or more elements from the set.
+func (s *RoaringUInt32Set) Remove(elems ...uint32) {
+	for _, e := range elems {
+		i, ok := s.find(uint16(e >> 16))
+		if !ok || !s.containers[i].remove(uint16(e)) || s.containers[i].card != 0 {
+			continue
+		}
+		s.keys = append(s.keys[:i], s.keys[i+1:]...)
+		s.containers = append(s.containers[:i], s.containers[i+1:]...)
+	}
+}
+
+// Empty empties the set.
+func (s *RoaringUInt32Set) Empty() {
+	s.keys, s.containers = nil, nil
+}
+
+// Has indicates whether the set has an element.
+func (s *RoaringUInt32Set) Has(elem uint32) bool {
+	i, ok := s.find(uint16(elem >> 16))
+	return ok && s.containers[i].has(uint16(elem))
+}
+
+// Size returns the size of the set.
+func (s *RoaringUInt32Set) Size() int {
+	n := 0
+	for _, c := range s.containers {
+		n += c.card
+	}
+	return n
+}
+
+// IsEmpty indicates whether the set is empty.
+func (s *RoaringUInt32Set) IsEmpty() bool {
+	return len(s.keys) == 0
+}
+
+// Clone returns a clone of the set.
+func (s *RoaringUInt32Set) Clone() *RoaringUInt32Set {
+	r := &RoaringUInt32Set{
+		keys:       append([]uint16(nil), s.keys...),
+		containers: make([]*container, len(s.containers)),
+	}
+	for i, c := range s.containers {
+		r.containers[i] = c.clone()
+	}
+	return r
+}
+
+// Optimize converts the containers of the set that hold runs of consecutive
+// elements to a run-length encoding, if that is smaller, which typically
+// reduces the memory and encoded size of a set of clustered elements.
+// It should be called after the set is built, as containers are converted
+// back to the other representations when they are modified.
+func (s *RoaringUInt32Set) Optimize() {
+	for _, c := range s.containers {
+		c.optimize()
+	}
+}
+
+// ascend calls f for each element of the set in ascending order,
+// until f returns false, and reports whether f never returned false.
+func (s *RoaringUInt32Set) ascend(f func(uint32) bool) bool {
+	for i, c := range s.containers {
+		hi := uint32(s.keys[i]) << 16
+		if !c.ascend(func(lo uint16) bool { return f(hi | uint32(lo)) }) {
+			return false
+		}
+	}
+	return true
+}
+
+// descend calls f for each element of the set in descending order,
+// until f returns false, and reports whether f never returned false.
+func (s *RoaringUInt32Set) descend(f func(uint32) bool) bool {
+	for i := len(s.containers) - 1; i >= 0; i-- {
+		hi := uint32(s.keys[i]) << 16
+		if !s.containers[i].descend(func(lo uint16) bool { return f(hi | uint32(lo)) }) {
+			return false
+		}
+	}
+	return true
+}
+
+// AsSlice returns an equivalent slice with the elements in ascending order.
+func (s *RoaringUInt32Set) AsSlice() []uint32 {
+	return s.AppendTo(make([]uint32, 0, s.Size()))
+}
+
+// AsSortedSlice returns an equivalent slice with the elements in ascending
+// order. It is the same as AsSlice.
+func (s *RoaringUInt32Set) AsSortedSlice() []uint32 {
+	return s.AsSlice()
+}
+
+// AsSortedSliceDesc returns an equivalent slice with the elements in
+// descending order.
+func (s *RoaringUInt32Set) AsSortedSliceDesc() []uint32 {
+	a := make([]uint32, 0, s.Size())
+	s.descend(func(e uint32) bool {
+		a = append(a, e)
+		return true
+	})
+	return a
+}
+
+// AppendTo appends the elements of the set to dst in ascending order and
+// returns the extended slice. It allocates only if dst does not have enough
+// capacity.
+func (s *RoaringUInt32Set) AppendTo(dst []uint32) []uint32 {
+	s.ascend(func(e uint32) bool {
+		dst = append(dst, e)
+		return true
+	})
+	return dst
+}
+
+// ToUInt32Set returns an equivalent UInt32Set.
+func (s *RoaringUInt32Set) ToUInt32Set() UInt32Set {
+	r := make(UInt32Set, s.Size())
+	s.ascend(func(e uint32) bool {
+		r[e] = struct{}{}
+		return true
+	})
+	return r
+}
+
+// String returns a string representation of the set,
+// with its elements in ascending order.
+func (s *RoaringUInt32Set) String() string {
+	b := &strings.Builder{}
+	b.Grow(s.Size() * 8)
+	writeElems(b, s.AsSlice(), "%v", " ")
+	return b.String()
+}
+
+// Format implements the fmt.Formatter interface.
+// See Set.Format for the supported verbs.
+func (s *RoaringUInt32Set) Format(f fmt.State, verb rune) {
+	formatElems(f, verb, s.AsSlice(), "menge.NewRoaringUInt32Set")
+}
+
+// Equals indicates whether s and t are equal.
+func (s *RoaringUInt32Set) Equals(t *RoaringUInt32Set) bool {
+	if len(s.keys) != len(t.keys) {
+		return false
+	}
+	for i, c := range s.containers {
+		d := t.containers[i]
+		if s.keys[i] != t.keys[i] || c.card != d.card || andCard(c, d) != c.card {
+			return false
+		}
+	}
+	return true
+}
+
+// combine returns the result of op on s and t.
+func (s *RoaringUInt32Set) combine(t *RoaringUInt32Set, op setOp) *RoaringUInt32Set {
+	r := &RoaringUInt32Set{}
+	add := func(k uint16, c *container) {
+		r.keys = append(r.keys, k)
+		r.containers = append(r.containers, c)
+	}
+	i, j := 0, 0
+	for i < len(s.keys) || j < len(t.keys) {
+		switch {
+		case j == len(t.keys) || i < len(s.keys) && s.keys[i] < t.keys[j]:
+			if op.keep(true, false) {
+				add(s.keys[i], s.containers[i].clone())
+			}
+			i++
+		case i == len(s.keys) || t.keys[j] < s.keys[i]:
+			if op.keep(false, true) {
+				add(t.keys[j], t.containers[j].clone())
+			}
+			j++
+		default:
+			if c := combine(s.containers[i], t.containers[j], op); c != nil {
+				add(s.keys[i], c)
+			}
+			i++
+			j++
+		}
+	}
+	return r
+}
+
+// Union returns the union of s and t.
+func (s *RoaringUInt32Set) Union(t *RoaringUInt32Set) *RoaringUInt32Set {
+	return s.combine(t, opUnion)
+}
+
+// Intersection returns the intersection of s and t.
+func (s *RoaringUInt32Set) Intersection(t *RoaringUInt32Set) *RoaringUInt32Set {
+	return s.combine(t, opIntersection)
+}
+
+// Difference returns the difference of s and t, i.e., s - t.
+func (s *RoaringUInt32Set) Difference(t *RoaringUInt32Set) *RoaringUInt32Set {
+	return s.combine(t, opDifference)
+}
+
+// SymmetricDifference returns the symmetric difference of s and t,
+// i.e., the elements that are in either s or t but not in both.
+func (s *RoaringUInt32Set) SymmetricDifference(t *RoaringUInt32Set) *RoaringUInt32Set {
+	return s.combine(t, opSymmetricDifference)
+}
+
+// UnionWith adds the elements of t to s, i.e., s = s ⋃ t.
+func (s *RoaringUInt32Set) UnionWith(t *RoaringUInt32Set) {
+	*s = *s.combine(t, opUnion)
+}
+
+// IntersectWith removes the elements of s that are not in t, i.e., s = s ⋂ t.
+func (s *RoaringUInt32Set) IntersectWith(t *RoaringUInt32Set) {
+	*s = *s.combine(t, opIntersection)
+}
+
+// DifferenceWith removes the elements of t from s, i.e., s = s - t.
+func (s *RoaringUInt32Set) DifferenceWith(t *RoaringUInt32Set) {
+	*s = *s.combine(t, opDifference)
+}
+
+// SymmetricDifferenceWith replaces s with the symmetric difference of s and t,
+// i.e., removes the elements of t that are in s and adds those that are not.
+func (s *RoaringUInt32Set) SymmetricDifferenceWith(t *RoaringUInt32Set) {
+	*s = *s.combine(t, opSymmetricDifference)
+}
+
+// IsSubsetOf indicates whether s is a subset of t.
+func (s *RoaringUInt32Set) IsSubsetOf(t *RoaringUInt32Set) bool {
+	for i, c := range s.containers {
+		j, ok := t.find(s.keys[i])
+		if !ok || c.card > t.containers[j].card || andCard(c, t.containers[j]) != c.card {
+			return false
+		}
+	}
+	return true
+}
+
+// IsProperSubsetOf indicates whether s is a proper subset of t.
+func (s *RoaringUInt32Set) IsProperSubsetOf(t *RoaringUInt32Set) bool {
+	return s.Size() < t.Size() && s.IsSubsetOf(t)
+}
+
+// IsSupersetOf indicates whether s is a superset of t.
+func (s *RoaringUInt32Set) IsSupersetOf(t *RoaringUInt32Set) bool {
+	return t.IsSubsetOf(s)
+}
+
+// IsProperSupersetOf indicates whether s is a proper superset of t.
+func (s *RoaringUInt32Set) IsProperSupersetOf(t *RoaringUInt32Set) bool {
+	return t.IsProperSubsetOf(s)
+}
+
+// IsDisjointFrom indicates whether s and t are disjoint.
+func (s *RoaringUInt32Set) IsDisjointFrom(t *RoaringUInt32Set) bool {
+	return s.IntersectionSize(t) == 0
+}
+
+// IntersectionSize returns the size of the intersection of s and t,
+// without computing the intersection.
+func (s *RoaringUInt32Set) IntersectionSize(t *RoaringUInt32Set) int {
+	n := 0
+	for i, c := range s.containers {
+		if j, ok := t.find(s.keys[i]); ok {
+			n += andCard(c, t.containers[j])
+		}
+	}
+	return n
+}
+
+// UnionSize returns the size of the union of s and t,
+// without computing the union.
+func (s *RoaringUInt32Set) UnionSize(t *RoaringUInt32Set) int {
+	return s.Size() + t.Size() - s.IntersectionSize(t)
+}
+
+// DifferenceSize returns the size of the difference of s and t, i.e., s - t,
+// without computing the difference.
+func (s *RoaringUInt32Set) DifferenceSize(t *RoaringUInt32Set) int {
+	return s.Size() - s.IntersectionSize(t)
+}
+
+// Jaccard returns the Jaccard index of s and t, i.e., |s ⋂ t| / |s ⋃ t|.
+// The Jaccard index of two empty sets is 1.
+func (s *RoaringUInt32Set) Jaccard(t *RoaringUInt32Set) float64 {
+	return jaccard(s.IntersectionSize(t), s.Size(), t.Size())
+}
+
+// SorensenDice returns the Sørensen–Dice coefficient of s and t,
+// i.e., 2|s ⋂ t| / (|s| + |t|).
+// The Sørensen–Dice coefficient of two empty sets is 1.
+func (s *RoaringUInt32Set) SorensenDice(t *RoaringUInt32Set) float64 {
+	return sorensenDice(s.IntersectionSize(t), s.Size(), t.Size())
+}
+
+// OverlapCoefficient returns the overlap coefficient of s and t,
+// i.e., |s ⋂ t| / min(|s|, |t|).
+// The overlap coefficient of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s *RoaringUInt32Set) OverlapCoefficient(t *RoaringUInt32Set) float64 {
+	return overlapCoefficient(s.IntersectionSize(t), s.Size(), t.Size())
+}
+
+// CosineSimilarity returns the cosine similarity of s and t,
+// i.e., |s ⋂ t| / √(|s||t|).
+// The cosine similarity of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s *RoaringUInt32Set) CosineSimilarity(t *RoaringUInt32Set) float64 {
+	return cosineSimilarity(s.IntersectionSize(t), s.Size(), t.Size())
+}
+
+// Filter returns a new set containing the elements of s for which pred
+// returns true.
+func (s *RoaringUInt32Set) Filter(pred func(elem uint32) bool) *RoaringUInt32Set {
+	r := &RoaringUInt32Set{}
+	for i, c := range s.containers {
+		hi := uint32(s.keys[i]) << 16
+		if f := filter(c, func(lo uint16) bool { return pred(hi | uint32(lo)) }); f != nil {
+			r.keys = append(r.keys, s.keys[i])
+			r.containers = append(r.containers, f)
+		}
+	}
+	return r
+}
+
+// Retain removes the elements of s for which pred returns false.
+func (s *RoaringUInt32Set) Retain(pred func(elem uint32) bool) {
+	*s = *s.Filter(pred)
+}
+
+// RemoveIf removes the elements of s for which pred returns true.
+func (s *RoaringUInt32Set) RemoveIf(pred func(elem uint32) bool) {
+	*s = *s.Filter(func(e uint32) bool { return !pred(e) })
+}
+
+// Partition returns two new sets containing the elements of s for which pred
+// returns true and false, respectively.
+func (s *RoaringUInt32Set) Partition(pred func(elem uint32) bool) (in, out *RoaringUInt32Set) {
+	in = s.Filter(pred)
+	return in, s.Difference(in)
+}
+
+// Any indicates whether pred returns true for any element of s.
+// It returns false for an empty set.
+func (s *RoaringUInt32Set) Any(pred func(elem uint32) bool) bool {
+	return !s.None(pred)
+}
+
+// Every indicates whether pred returns true for all elements of s.
+// It returns true for an empty set.
+func (s *RoaringUInt32Set) Every(pred func(elem uint32) bool) bool {
+	return s.ascend(pred)
+}
+
+// None indicates whether pred returns false for all elements of s.
+// It returns true for an empty set.
+func (s *RoaringUInt32Set) None(pred func(elem uint32) bool) bool {
+	return s.ascend(func(e uint32) bool { return !pred(e) })
+}
+
+// Count returns the number of elements of s for which pred returns true.
+func (s *RoaringUInt32Set) Count(pred func(elem uint32) bool) int {
+	n := 0
+	s.ascend(func(e uint32) bool {
+		if pred(e) {
+			n++
+		}
+		return true
+	})
+	return n
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// See Set.MarshalJSON for the encoding.
+func (s *RoaringUInt32Set) MarshalJSON() ([]byte, error) {
+	return marshalElems(s.AsSlice())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It replaces the elements of the set with the decoded ones.
+func (s *RoaringUInt32Set) UnmarshalJSON(data []byte) error {
+	var t Set[uint32]
+	if err := json.Unmarshal(data, &t); err != nil || t == nil {
+		return err
+	}
+	*s = *FromUInt32Set(UInt32Set(t))
+	return nil
+}
+
+// The cookies that start the portable format of the Roaring specification,
+// with and without run containers.
+const (
+	roaringCookie      = 12347
+	roaringCookieNoRun = 12346
+)
+
+// roaringNoOffsetThreshold is the number of containers below which the
+// offset header is omitted if there are run containers.
+const roaringNoOffsetThreshold = 4
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The set is encoded in the portable format of the Roaring format
+// specification (https://github.com/RoaringBitmap/RoaringFormatSpec),
+// which other roaring libraries can decode.
+func (s *RoaringUInt32Set) MarshalBinary() ([]byte, error) {
+	return s.appendBinary(nil), nil
+}
+
+// appendBinary appends the encoding of s to b and returns the extended slice.
+func (s *RoaringUInt32Set) appendBinary(b []byte) []byte {
+	n := len(s.containers)
+	hasRuns := false
+	for _, c := range s.containers {
+		hasRuns = hasRuns || c.kind == runContainer
+	}
+	start := len(b)
+	if hasRuns {
+		b = appendUint32(b, roaringCookie|uint32(n-1)<<16)
+		flags := make([]byte, (n+7)/8)
+		for i, c := range s.containers {
+			if c.kind == runContainer {
+				flags[i/8] |= 1 << (i % 8)
+			}
+		}
+		b = append(b, flags...)
+	} else {
+		b = appendUint32(b, roaringCookieNoRun)
+		b = appendUint32(b, uint32(n))
+	}
+	for i, c := range s.containers {
+		b = appendUint16(b, s.keys[i])
+		b = appendUint16(b, uint16(c.card-1))
+	}
+	if !hasRuns || n >= roaringNoOffsetThreshold {
+		offset := len(b) - start + 4*n
+		for _, c := range s.containers {
+			b = appendUint32(b, uint32(offset))
+			offset += c.encodedSize()
+		}
+	}
+	for _, c := range s.containers {
+		switch c.kind {
+		case arrayContainer:
+			for _, x := range c.array {
+				b = appendUint16(b, x)
+			}
+		case bitmapContainer:
+			for _, w := range c.words {
+				b = appendUint64(b, w)
+			}
+		case runContainer:
+			b = appendUint16(b, uint16(len(c.runs)))
+			for _, r := range c.runs {
+				b = appendUint16(b, r.start)
+				b = appendUint16(b, r.last-r.start)
+			}
+		}
+	}
+	return b
+}
+
+// encodedSize returns the size of the encoding of the container.
+func (c *container) encodedSize() int {
+	switch c.kind {
+	case arrayContainer:
+		return 2 * c.card
+	case bitmapContainer:
+		return 8 * bitmapWords
+	}
+	return 2 + 4*len(c.runs)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// It decodes the portable format of the Roaring format specification and
+// replaces the elements of the set with the decoded ones.
+func (s *RoaringUInt32Set) UnmarshalBinary(data []byte) error {
+	var r RoaringUInt32Set
+	n, err := r.decode(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return errors.New("menge: trailing data after roaring bitmap")
+	}
+	*s = r
+	return nil
+}
+
+// errRoaringShort is returned when the encoding of a roaring bitmap is
+// truncated.
+var errRoaringShort = errors.New("menge: roaring bitmap is truncated")
+
+// decode decodes a roaring bitmap from the start of data into s and returns
+// the number of bytes it read. s is modified only if decoding succeeds.
+func (s *RoaringUInt32Set) decode(data []byte) (int, error) {
+	d := decoder{data: data}
+	cookie, ok := d.uint32()
+	if !ok {
+		return 0, errRoaringShort
+	}
+	var n int
+	var flags []byte
+	switch {
+	case cookie&0xffff == roaringCookie:
+		n = int(cookie>>16) + 1
+		if flags, ok = d.bytes((n + 7) / 8); !ok {
+			return 0, errRoaringShort
+		}
+	case cookie == roaringCookieNoRun:
+		size, ok := d.uint32()
+		if !ok {
+			return 0, errRoaringShort
+		}
+		if size > 1<<16 {
+			return 0, fmt.Errorf("menge: roaring bitmap has %d containers", size)
+		}
+		n = int(size)
+	default:
+		return 0, fmt.Errorf("menge: invalid roaring bitmap cookie %#x", cookie)
+	}
+	keys := make([]uint16, n)
+	cards := make([]int, n)
+	for i := range keys {
+		k, ok1 := d.uint16()
+		c, ok2 := d.uint16()
+		if !ok1 || !ok2 {
+			return 0, errRoaringShort
+		}
+		if i > 0 && k <= keys[i-1] {
+			return 0, errors.New("menge: roaring bitmap keys are not ascending")
+		}
+		keys[i], cards[i] = k, int(c)+1
+	}
+	if flags == nil || n >= roaringNoOffsetThreshold {
+		if _, ok := d.bytes(4 * n); !ok {
+			return 0, errRoaringShort
+		}
+	}
+	containers := make([]*container, n)
+	for i := range containers {
+		c := &container{card: cards[i]}
+		switch {
+		case flags != nil && flags[i/8]&(1<<(i%8)) != 0:
+			c.kind = runContainer
+			if err := d.runs(c); err != nil {
+				return 0, err
+			}
+		case c.card > arrayMax:
+			c.kind = bitmapContainer
+			if err := d.words(c); err != nil {
+				return 0, err
+			}
+		default:
+			c.kind = arrayContainer
+			if err := d.array(c); err != nil {
+				return 0, err
+			}
+		}
+		containers[i] = c
+	}
+	s.keys, s.containers = keys, containers
+	return d.off, nil
+}
+
+// decoder reads little-endian values from data.
+type decoder struct {
+	data []byte
+	off  int
+}
+
+func (d *decoder) bytes(n int) ([]byte, bool) {
+	if n < 0 || len(d.data)-d.off < n {
+		return nil, false
+	}
+	b := d.data[d.off : d.off+n]
+	d.off += n
+	return b, true
+}
+
+func (d *decoder) uint16() (uint16, bool) {
+	b, ok := d.bytes(2)
+	if !ok {
+		return 0, false
+	}
+	return binary.LittleEndian.Uint16(b), true
+}
+
+func (d *decoder) uint32() (uint32, bool) {
+	b, ok := d.bytes(4)
+	if !ok {
+		return 0, false
+	}
+	return binary.LittleEndian.Uint32(b), true
+}
+
+func (d *decoder) uint64() (uint64, bool) {
+	b, ok := d.bytes(8)
+	if !ok {
+		return 0, false
+	}
+	return binary.LittleEndian.Uint64(b), true
+}
+
+// array reads the elements of the array container c.
+func (d *decoder) array(c *container) error {
+	c.array = make([]uint16, c.card)
+	for i := range c.array {
+		x, ok := d.uint16()
+		if !ok {
+			return errRoaringShort
+		}
+		if i > 0 && x <= c.array[i-1] {
+			return errors.New("menge: roaring array container is not ascending")
+		}
+		c.array[i] = x
+	}
+	return nil
+}
+
+// words reads the bitmap of the bitmap container c.
+func (d *decoder) words(c *container) error {
+	c.words = make([]uint64, bitmapWords)
+	card := 0
+	for i := range c.words {
+		w, ok := d.uint64()
+		if !ok {
+			return errRoaringShort
+		}
+		c.words[i] = w
+		card += bits.OnesCount64(w)
+	}
+	if card != c.card {
+		return fmt.Errorf("menge: roaring bitmap container has %d elements, want %d", card, c.card)
+	}
+	return nil
+}
+
+// runs reads the runs of the run container c.
+func (d *decoder) runs(c *container) error {
+	n, ok := d.uint16()
+	if !ok {
+		return errRoaringShort
+	}
+	c.runs = make([]interval, n)
+	card := 0
+	for i := range c.runs {
+		start, ok1 := d.uint16()
+		length, ok2 := d.uint16()
+		if !ok1 || !ok2 {
+			return errRoaringShort
+		}
+		if int(start)+int(length) > 0xffff || i > 0 && int(start) <= int(c.runs[i-1].last)+1 {
+			return errors.New("menge: roaring run container is invalid")
+		}
+		c.runs[i] = interval{start, start + length}
+		card += int(length) + 1
+	}
+	if card != c.card {
+		return fmt.Errorf("menge: roaring run container has %d elements, want %d", card, c.card)
+	}
+	return nil
+}
+
+// FromUInt32Set returns a new RoaringUInt32Set containing the elements of s.
+func FromUInt32Set(s UInt32Set) *RoaringUInt32Set {
+	a := Set[uint32](s).AsSlice()
+	sort.Slice(a, func(i, j int) bool { return a[i] < a[j] })
+	r := &RoaringUInt32Set{}
+	for len(a) != 0 {
+		hi := uint16(a[0] >> 16)
+		n := sort.Search(len(a), func(i int) bool { return uint16(a[i]>>16) != hi })
+		lo := make([]uint16, n)
+		for i, e := range a[:n] {
+			lo[i] = uint16(e)
+		}
+		r.keys = append(r.keys, hi)
+		r.containers = append(r.containers, fromArray(lo))
+		a = a[n:]
+	}
+	return r
+}
+
+// NewRoaringUInt32Set returns a new RoaringUInt32Set containing zero or more
+// elements.
+func NewRoaringUInt32Set(elems ...uint32) *RoaringUInt32Set {
+	s := &RoaringUInt32Set{}
+	s.Add(elems...)
+	return s
+}
+
+// appendUint16 appends the little-endian encoding of x to b.
+func appendUint16(b []byte, x uint16) []byte {
+	return append(b, byte(x), byte(x>>8))
+}
+
+// appendUint32 appends the little-endian encoding of x to b.
+func appendUint32(b []byte, x uint32) []byte {
+	return append(b, byte(x), byte(x>>8), byte(x>>16), byte(x>>24))
+}
+
+// appendUint64 appends the little-endian encoding of x to b.
+func appendUint64(b []byte, x uint64) []byte {
+	return appendUint32(appendUint32(b, uint32(x)), uint32(x>>32))
+}
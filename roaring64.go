@@ -0,0 +1,522 @@
+package menge
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// RoaringUInt64Set represents a set of uint64 elements as a compressed
+// roaring bitmap. The elements are partitioned by their high 32 bits into
+// RoaringUInt32Sets that hold their low 32 bits.
+// MarshalBinary and UnmarshalBinary use the portable 64-bit format of the
+// Roaring format specification.
+// The zero value is an empty set ready to use.
+type RoaringUInt64Set struct {
+	keys    []uint32
+	buckets []*RoaringUInt32Set
+}
+
+// find returns the index of the bucket with the high bits hi, or the index
+// where it would be inserted, and whether it exists.
+func (s *RoaringUInt64Set) find(hi uint32) (int, bool) {
+	i := sort.Search(len(s.keys), func(i int) bool { return s.keys[i] >= hi })
+	return i, i < len(s.keys) && s.keys[i] == hi
+}
+
+// Add adds zero or more elements to the set.
+func (s *RoaringUInt64Set) Add(elems ...uint64) {
+	for _, e := range elems {
+		hi := uint32(e >> 32)
+		i, ok := s.find(hi)
+		if !ok {
+			s.keys = append(s.keys, 0)
+			copy(s.keys[i+1:], s.keys[i:])
+			s.keys[i] = hi
+			s.buckets = append(s.buckets, nil)
+			copy(s.buckets[i+1:], s.buckets[i:])
+			s.buckets[i] = &RoaringUInt32Set{}
+		}
+		s.buckets[i].Add(uint32(e))
+	}
+}
+
+// Remove removes zero or more elements from the set.
+func (s *RoaringUInt64Set) Remove(elems ...uint64) {
+	for _, e := range elems {
+		i, ok := s.find(uint32(e >> 32))
+		if !ok {
+			continue
+		}
+		s.buckets[i].Remove(uint32(e))
+		if s.buckets[i].IsEmpty() {
+			s.keys = append(s.keys[:i], s.keys[i+1:]...)
+			s.buckets = append(s.buckets[:i], s.buckets[i+1:]...)
+		}
+	}
+}
+
+// Empty empties the set.
+func (s *RoaringUInt64Set) Empty() {
+	s.keys, s.buckets = nil, nil
+}
+
+// Has indicates whether the set has an element.
+func (s *RoaringUInt64Set) Has(elem uint64) bool {
+	i, ok := s.find(uint32(elem >> 32))
+	return ok && s.buckets[i].Has(uint32(elem))
+}
+
+// Size returns the size of the set.
+func (s *RoaringUInt64Set) Size() int {
+	n := 0
+	for _, b := range s.buckets {
+		n += b.Size()
+	}
+	return n
+}
+
+// IsEmpty indicates whether the set is empty.
+func (s *RoaringUInt64Set) IsEmpty() bool {
+	return len(s.keys) == 0
+}
+
+// Clone returns a clone of the set.
+func (s *RoaringUInt64Set) Clone() *RoaringUInt64Set {
+	r := &RoaringUInt64Set{
+		keys:    append([]uint32(nil), s.keys...),
+		buckets: make([]*RoaringUInt32Set, len(s.buckets)),
+	}
+	for i, b := range s.buckets {
+		r.buckets[i] = b.Clone()
+	}
+	return r
+}
+
+// Optimize converts the containers of the set that hold runs of consecutive
+// elements to a run-length encoding, if that is smaller.
+// See RoaringUInt32Set.Optimize.
+func (s *RoaringUInt64Set) Optimize() {
+	for _, b := range s.buckets {
+		b.Optimize()
+	}
+}
+
+// ascend calls f for each element of the set in ascending order,
+// until f returns false, and reports whether f never returned false.
+func (s *RoaringUInt64Set) ascend(f func(uint64) bool) bool {
+	for i, b := range s.buckets {
+		hi := uint64(s.keys[i]) << 32
+		if !b.ascend(func(lo uint32) bool { return f(hi | uint64(lo)) }) {
+			return false
+		}
+	}
+	return true
+}
+
+// descend calls f for each element of the set in descending order,
+// until f returns false, and reports whether f never returned false.
+func (s *RoaringUInt64Set) descend(f func(uint64) bool) bool {
+	for i := len(s.buckets) - 1; i >= 0; i-- {
+		hi := uint64(s.keys[i]) << 32
+		if !s.buckets[i].descend(func(lo uint32) bool { return f(hi | uint64(lo)) }) {
+			return false
+		}
+	}
+	return true
+}
+
+// AsSlice returns an equivalent slice with the elements in ascending order.
+func (s *RoaringUInt64Set) AsSlice() []uint64 {
+	return s.AppendTo(make([]uint64, 0, s.Size()))
+}
+
+// AsSortedSlice returns an equivalent slice with the elements in ascending
+// order. It is the same as AsSlice.
+func (s *RoaringUInt64Set) AsSortedSlice() []uint64 {
+	return s.AsSlice()
+}
+
+// AsSortedSliceDesc returns an equivalent slice with the elements in
+// descending order.
+func (s *RoaringUInt64Set) AsSortedSliceDesc() []uint64 {
+	a := make([]uint64, 0, s.Size())
+	s.descend(func(e uint64) bool {
+		a = append(a, e)
+		return true
+	})
+	return a
+}
+
+// AppendTo appends the elements of the set to dst in ascending order and
+// returns the extended slice. It allocates only if dst does not have enough
+// capacity.
+func (s *RoaringUInt64Set) AppendTo(dst []uint64) []uint64 {
+	s.ascend(func(e uint64) bool {
+		dst = append(dst, e)
+		return true
+	})
+	return dst
+}
+
+// ToUInt64Set returns an equivalent UInt64Set.
+func (s *RoaringUInt64Set) ToUInt64Set() UInt64Set {
+	r := make(UInt64Set, s.Size())
+	s.ascend(func(e uint64) bool {
+		r[e] = struct{}{}
+		return true
+	})
+	return r
+}
+
+// String returns a string representation of the set,
+// with its elements in ascending order.
+func (s *RoaringUInt64Set) String() string {
+	b := &strings.Builder{}
+	b.Grow(s.Size() * 12)
+	writeElems(b, s.AsSlice(), "%v", " ")
+	return b.String()
+}
+
+// Format implements the fmt.Formatter interface.
+// See Set.Format for the supported verbs.
+func (s *RoaringUInt64Set) Format(f fmt.State, verb rune) {
+	formatElems(f, verb, s.AsSlice(), "menge.NewRoaringUInt64Set")
+}
+
+// Equals indicates whether s and t are equal.
+func (s *RoaringUInt64Set) Equals(t *RoaringUInt64Set) bool {
+	if len(s.keys) != len(t.keys) {
+		return false
+	}
+	for i, b := range s.buckets {
+		if s.keys[i] != t.keys[i] || !b.Equals(t.buckets[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// combine returns the result of op on s and t.
+func (s *RoaringUInt64Set) combine(t *RoaringUInt64Set, op setOp) *RoaringUInt64Set {
+	r := &RoaringUInt64Set{}
+	add := func(k uint32, b *RoaringUInt32Set) {
+		r.keys = append(r.keys, k)
+		r.buckets = append(r.buckets, b)
+	}
+	i, j := 0, 0
+	for i < len(s.keys) || j < len(t.keys) {
+		switch {
+		case j == len(t.keys) || i < len(s.keys) && s.keys[i] < t.keys[j]:
+			if op.keep(true, false) {
+				add(s.keys[i], s.buckets[i].Clone())
+			}
+			i++
+		case i == len(s.keys) || t.keys[j] < s.keys[i]:
+			if op.keep(false, true) {
+				add(t.keys[j], t.buckets[j].Clone())
+			}
+			j++
+		default:
+			if b := s.buckets[i].combine(t.buckets[j], op); !b.IsEmpty() {
+				add(s.keys[i], b)
+			}
+			i++
+			j++
+		}
+	}
+	return r
+}
+
+// Union returns the union of s and t.
+func (s *RoaringUInt64Set) Union(t *RoaringUInt64Set) *RoaringUInt64Set {
+	return s.combine(t, opUnion)
+}
+
+// Intersection returns the intersection of s and t.
+func (s *RoaringUInt64Set) Intersection(t *RoaringUInt64Set) *RoaringUInt64Set {
+	return s.combine(t, opIntersection)
+}
+
+// Difference returns the difference of s and t, i.e., s - t.
+func (s *RoaringUInt64Set) Difference(t *RoaringUInt64Set) *RoaringUInt64Set {
+	return s.combine(t, opDifference)
+}
+
+// SymmetricDifference returns the symmetric difference of s and t,
+// i.e., the elements that are in either s or t but not in both.
+func (s *RoaringUInt64Set) SymmetricDifference(t *RoaringUInt64Set) *RoaringUInt64Set {
+	return s.combine(t, opSymmetricDifference)
+}
+
+// UnionWith adds the elements of t to s, i.e., s = s ⋃ t.
+func (s *RoaringUInt64Set) UnionWith(t *RoaringUInt64Set) {
+	*s = *s.combine(t, opUnion)
+}
+
+// IntersectWith removes the elements of s that are not in t, i.e., s = s ⋂ t.
+func (s *RoaringUInt64Set) IntersectWith(t *RoaringUInt64Set) {
+	*s = *s.combine(t, opIntersection)
+}
+
+// DifferenceWith removes the elements of t from s, i.e., s = s - t.
+func (s *RoaringUInt64Set) DifferenceWith(t *RoaringUInt64Set) {
+	*s = *s.combine(t, opDifference)
+}
+
+// SymmetricDifferenceWith replaces s with the symmetric difference of s and t,
+// i.e., removes the elements of t that are in s and adds those that are not.
+func (s *RoaringUInt64Set) SymmetricDifferenceWith(t *RoaringUInt64Set) {
+	*s = *s.combine(t, opSymmetricDifference)
+}
+
+// IsSubsetOf indicates whether s is a subset of t.
+func (s *RoaringUInt64Set) IsSubsetOf(t *RoaringUInt64Set) bool {
+	for i, b := range s.buckets {
+		j, ok := t.find(s.keys[i])
+		if !ok || !b.IsSubsetOf(t.buckets[j]) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsProperSubsetOf indicates whether s is a proper subset of t.
+func (s *RoaringUInt64Set) IsProperSubsetOf(t *RoaringUInt64Set) bool {
+	return s.Size() < t.Size() && s.IsSubsetOf(t)
+}
+
+// IsSupersetOf indicates whether s is a superset of t.
+func (s *RoaringUInt64Set) IsSupersetOf(t *RoaringUInt64Set) bool {
+	return t.IsSubsetOf(s)
+}
+
+// IsProperSupersetOf indicates whether s is a proper superset of t.
+func (s *RoaringUInt64Set) IsProperSupersetOf(t *RoaringUInt64Set) bool {
+	return t.IsProperSubsetOf(s)
+}
+
+// IsDisjointFrom indicates whether s and t are disjoint.
+func (s *RoaringUInt64Set) IsDisjointFrom(t *RoaringUInt64Set) bool {
+	return s.IntersectionSize(t) == 0
+}
+
+// IntersectionSize returns the size of the intersection of s and t,
+// without computing the intersection.
+func (s *RoaringUInt64Set) IntersectionSize(t *RoaringUInt64Set) int {
+	n := 0
+	for i, b := range s.buckets {
+		if j, ok := t.find(s.keys[i]); ok {
+			n += b.IntersectionSize(t.buckets[j])
+		}
+	}
+	return n
+}
+
+// UnionSize returns the size of the union of s and t,
+// without computing the union.
+func (s *RoaringUInt64Set) UnionSize(t *RoaringUInt64Set) int {
+	return s.Size() + t.Size() - s.IntersectionSize(t)
+}
+
+// DifferenceSize returns the size of the difference of s and t, i.e., s - t,
+// without computing the difference.
+func (s *RoaringUInt64Set) DifferenceSize(t *RoaringUInt64Set) int {
+	return s.Size() - s.IntersectionSize(t)
+}
+
+// Jaccard returns the Jaccard index of s and t, i.e., |s ⋂ t| / |s ⋃ t|.
+// The Jaccard index of two empty sets is 1.
+func (s *RoaringUInt64Set) Jaccard(t *RoaringUInt64Set) float64 {
+	return jaccard(s.IntersectionSize(t), s.Size(), t.Size())
+}
+
+// SorensenDice returns the Sørensen–Dice coefficient of s and t,
+// i.e., 2|s ⋂ t| / (|s| + |t|).
+// The Sørensen–Dice coefficient of two empty sets is 1.
+func (s *RoaringUInt64Set) SorensenDice(t *RoaringUInt64Set) float64 {
+	return sorensenDice(s.IntersectionSize(t), s.Size(), t.Size())
+}
+
+// OverlapCoefficient returns the overlap coefficient of s and t,
+// i.e., |s ⋂ t| / min(|s|, |t|).
+// The overlap coefficient of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s *RoaringUInt64Set) OverlapCoefficient(t *RoaringUInt64Set) float64 {
+	return overlapCoefficient(s.IntersectionSize(t), s.Size(), t.Size())
+}
+
+// CosineSimilarity returns the cosine similarity of s and t,
+// i.e., |s ⋂ t| / √(|s||t|).
+// The cosine similarity of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s *RoaringUInt64Set) CosineSimilarity(t *RoaringUInt64Set) float64 {
+	return cosineSimilarity(s.IntersectionSize(t), s.Size(), t.Size())
+}
+
+// Filter returns a new set containing the elements of s for which pred
+// returns true.
+func (s *RoaringUInt64Set) Filter(pred func(elem uint64) bool) *RoaringUInt64Set {
+	r := &RoaringUInt64Set{}
+	for i, b := range s.buckets {
+		hi := uint64(s.keys[i]) << 32
+		if f := b.Filter(func(lo uint32) bool { return pred(hi | uint64(lo)) }); !f.IsEmpty() {
+			r.keys = append(r.keys, s.keys[i])
+			r.buckets = append(r.buckets, f)
+		}
+	}
+	return r
+}
+
+// Retain removes the elements of s for which pred returns false.
+func (s *RoaringUInt64Set) Retain(pred func(elem uint64) bool) {
+	*s = *s.Filter(pred)
+}
+
+// RemoveIf removes the elements of s for which pred returns true.
+func (s *RoaringUInt64Set) RemoveIf(pred func(elem uint64) bool) {
+	*s = *s.Filter(func(e uint64) bool { return !pred(e) })
+}
+
+// Partition returns two new sets containing the elements of s for which pred
+// returns true and false, respectively.
+func (s *RoaringUInt64Set) Partition(pred func(elem uint64) bool) (in, out *RoaringUInt64Set) {
+	in = s.Filter(pred)
+	return in, s.Difference(in)
+}
+
+// Any indicates whether pred returns true for any element of s.
+// It returns false for an empty set.
+func (s *RoaringUInt64Set) Any(pred func(elem uint64) bool) bool {
+	return !s.None(pred)
+}
+
+// Every indicates whether pred returns true for all elements of s.
+// It returns true for an empty set.
+func (s *RoaringUInt64Set) Every(pred func(elem uint64) bool) bool {
+	return s.ascend(pred)
+}
+
+// None indicates whether pred returns false for all elements of s.
+// It returns true for an empty set.
+func (s *RoaringUInt64Set) None(pred func(elem uint64) bool) bool {
+	return s.ascend(func(e uint64) bool { return !pred(e) })
+}
+
+// Count returns the number of elements of s for which pred returns true.
+func (s *RoaringUInt64Set) Count(pred func(elem uint64) bool) int {
+	n := 0
+	s.ascend(func(e uint64) bool {
+		if pred(e) {
+			n++
+		}
+		return true
+	})
+	return n
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// See Set.MarshalJSON for the encoding.
+func (s *RoaringUInt64Set) MarshalJSON() ([]byte, error) {
+	return marshalElems(s.AsSlice())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It replaces the elements of the set with the decoded ones.
+func (s *RoaringUInt64Set) UnmarshalJSON(data []byte) error {
+	var t Set[uint64]
+	if err := json.Unmarshal(data, &t); err != nil || t == nil {
+		return err
+	}
+	*s = *FromUInt64Set(UInt64Set(t))
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The set is encoded in the portable 64-bit format of the Roaring format
+// specification: the number of buckets as a 64-bit integer, followed by the
+// high 32 bits of each bucket as a 32-bit integer and the bucket encoded as
+// by RoaringUInt32Set.MarshalBinary, all little-endian.
+func (s *RoaringUInt64Set) MarshalBinary() ([]byte, error) {
+	b := appendUint64(nil, uint64(len(s.buckets)))
+	for i, bucket := range s.buckets {
+		b = appendUint32(b, s.keys[i])
+		b = bucket.appendBinary(b)
+	}
+	return b, nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// It decodes the portable 64-bit format of the Roaring format specification
+// and replaces the elements of the set with the decoded ones.
+func (s *RoaringUInt64Set) UnmarshalBinary(data []byte) error {
+	d := decoder{data: data}
+	n, ok := d.uint64()
+	if !ok {
+		return errRoaringShort
+	}
+	if n > uint64(len(data)) {
+		return fmt.Errorf("menge: roaring bitmap has %d buckets", n)
+	}
+	r := RoaringUInt64Set{
+		keys:    make([]uint32, 0, n),
+		buckets: make([]*RoaringUInt32Set, 0, n),
+	}
+	// prev is the previous key, kept apart from r.keys as empty buckets are
+	// dropped.
+	var prev uint32
+	for i := uint64(0); i < n; i++ {
+		k, ok := d.uint32()
+		if !ok {
+			return errRoaringShort
+		}
+		if i > 0 && k <= prev {
+			return errors.New("menge: roaring bitmap keys are not ascending")
+		}
+		prev = k
+		b := &RoaringUInt32Set{}
+		m, err := b.decode(data[d.off:])
+		if err != nil {
+			return err
+		}
+		d.off += m
+		if !b.IsEmpty() {
+			r.keys = append(r.keys, k)
+			r.buckets = append(r.buckets, b)
+		}
+	}
+	if d.off != len(data) {
+		return errors.New("menge: trailing data after roaring bitmap")
+	}
+	*s = r
+	return nil
+}
+
+// FromUInt64Set returns a new RoaringUInt64Set containing the elements of s.
+func FromUInt64Set(s UInt64Set) *RoaringUInt64Set {
+	a := Set[uint64](s).AsSlice()
+	sort.Slice(a, func(i, j int) bool { return a[i] < a[j] })
+	r := &RoaringUInt64Set{}
+	for len(a) != 0 {
+		hi := uint32(a[0] >> 32)
+		n := sort.Search(len(a), func(i int) bool { return uint32(a[i]>>32) != hi })
+		b := &RoaringUInt32Set{}
+		for _, e := range a[:n] {
+			b.Add(uint32(e))
+		}
+		r.keys = append(r.keys, hi)
+		r.buckets = append(r.buckets, b)
+		a = a[n:]
+	}
+	return r
+}
+
+// NewRoaringUInt64Set returns a new RoaringUInt64Set containing zero or more
+// elements.
+func NewRoaringUInt64Set(elems ...uint64) *RoaringUInt64Set {
+	s := &RoaringUInt64Set{}
+	s.Add(elems...)
+	return s
+}
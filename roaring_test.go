@@ -0,0 +1,408 @@
+package menge_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/soroushj/menge"
+)
+
+// roaringSets returns sets of elements that make array, bitmap and run
+// containers, across one or more high bits.
+func roaringSets() [][]uint32 {
+	span := func(lo, hi, step uint32) []uint32 {
+		var a []uint32
+		for e := lo; e < hi; e += step {
+			a = append(a, e)
+		}
+		return a
+	}
+	return [][]uint32{
+		{},
+		{1, 2, 3},
+		{0, 1<<16 + 5, 5 << 16, 1<<32 - 1},
+		span(0, 10000, 1),
+		span(5000, 40000, 3),
+		append(span(60000, 70000, 2), span(1<<20, 1<<20+100, 1)...),
+	}
+}
+
+// TestRoaringUInt32Set compares the results of RoaringUInt32Set with those of
+// Set, with and without run containers.
+func TestRoaringUInt32Set(t *testing.T) {
+	sets := roaringSets()
+	build := func(a []uint32, optimize bool) *menge.RoaringUInt32Set {
+		s := menge.NewRoaringUInt32Set(a...)
+		if optimize {
+			s.Optimize()
+		}
+		return s
+	}
+	for _, optimize := range []bool{false, true} {
+		for _, a := range sets {
+			for _, b := range sets {
+				s, u := build(a, optimize), build(b, !optimize)
+				ms, mu := menge.NewSet(a...), menge.NewSet(b...)
+				if got, want := s.Equals(u), ms.Equals(mu); got != want {
+					t.Errorf("%d Equals %d got: %v", len(a), len(b), got)
+				}
+				for _, op := range []struct {
+					name string
+					f    func(s, u *menge.RoaringUInt32Set) *menge.RoaringUInt32Set
+					g    func(s, u menge.Set[uint32]) menge.Set[uint32]
+				}{
+					{"Union", (*menge.RoaringUInt32Set).Union, menge.Set[uint32].Union},
+					{"Intersection", (*menge.RoaringUInt32Set).Intersection, menge.Set[uint32].Intersection},
+					{"Difference", (*menge.RoaringUInt32Set).Difference, menge.Set[uint32].Difference},
+					{"SymmetricDifference", (*menge.RoaringUInt32Set).SymmetricDifference, menge.Set[uint32].SymmetricDifference},
+				} {
+					got, want := op.f(s, u), op.g(ms, mu)
+					if !reflect.DeepEqual(got.AsSlice(), want.AsSortedSlice()) || got.Size() != want.Size() {
+						t.Errorf("%d %s %d got size: %d", len(a), op.name, len(b), got.Size())
+					}
+				}
+				for _, op := range []struct {
+					name string
+					f    func(s, u *menge.RoaringUInt32Set)
+					g    func(s, u menge.Set[uint32])
+				}{
+					{"UnionWith", (*menge.RoaringUInt32Set).UnionWith, menge.Set[uint32].UnionWith},
+					{"IntersectWith", (*menge.RoaringUInt32Set).IntersectWith, menge.Set[uint32].IntersectWith},
+					{"DifferenceWith", (*menge.RoaringUInt32Set).DifferenceWith, menge.Set[uint32].DifferenceWith},
+					{"SymmetricDifferenceWith", (*menge.RoaringUInt32Set).SymmetricDifferenceWith, menge.Set[uint32].SymmetricDifferenceWith},
+				} {
+					got, want := s.Clone(), ms.Clone()
+					op.f(got, u)
+					op.g(want, mu)
+					if !reflect.DeepEqual(got.AsSlice(), want.AsSortedSlice()) {
+						t.Errorf("%d %s %d got size: %d", len(a), op.name, len(b), got.Size())
+					}
+				}
+				for _, op := range []struct {
+					name string
+					f    func(s, u *menge.RoaringUInt32Set) bool
+					g    func(s, u menge.Set[uint32]) bool
+				}{
+					{"IsSubsetOf", (*menge.RoaringUInt32Set).IsSubsetOf, menge.Set[uint32].IsSubsetOf},
+					{"IsProperSubsetOf", (*menge.RoaringUInt32Set).IsProperSubsetOf, menge.Set[uint32].IsProperSubsetOf},
+					{"IsSupersetOf", (*menge.RoaringUInt32Set).IsSupersetOf, menge.Set[uint32].IsSupersetOf},
+					{"IsProperSupersetOf", (*menge.RoaringUInt32Set).IsProperSupersetOf, menge.Set[uint32].IsProperSupersetOf},
+					{"IsDisjointFrom", (*menge.RoaringUInt32Set).IsDisjointFrom, menge.Set[uint32].IsDisjointFrom},
+				} {
+					if got, want := op.f(s, u), op.g(ms, mu); got != want {
+						t.Errorf("%d %s %d got: %v", len(a), op.name, len(b), got)
+					}
+				}
+				if got, want := s.IntersectionSize(u)+s.UnionSize(u)+s.DifferenceSize(u), ms.IntersectionSize(mu)+ms.UnionSize(mu)+ms.DifferenceSize(mu); got != want {
+					t.Errorf("%d sizes %d got: %v", len(a), len(b), got)
+				}
+				if got, want := s.Jaccard(u)+s.SorensenDice(u)+s.OverlapCoefficient(u)+s.CosineSimilarity(u),
+					ms.Jaccard(mu)+ms.SorensenDice(mu)+ms.OverlapCoefficient(mu)+ms.CosineSimilarity(mu); got != want {
+					t.Errorf("%d similarity %d got: %v", len(a), len(b), got)
+				}
+			}
+			s, ms := build(a, optimize), menge.NewSet(a...)
+			if s.Size() != ms.Size() || s.IsEmpty() != ms.IsEmpty() || s.String() != ms.String() {
+				t.Errorf("%d got size: %d", len(a), s.Size())
+			}
+			if !reflect.DeepEqual(s.AsSortedSliceDesc(), ms.AsSortedSliceDesc()) || !reflect.DeepEqual(s.AppendTo([]uint32{0}), append([]uint32{0}, ms.AsSortedSlice()...)) {
+				t.Errorf("%d slices got size: %d", len(a), s.Size())
+			}
+			if !reflect.DeepEqual(s.ToUInt32Set(), menge.UInt32Set(ms)) || !menge.FromUInt32Set(menge.UInt32Set(ms)).Equals(s) {
+				t.Errorf("%d conversion got size: %d", len(a), s.Size())
+			}
+			odd := func(e uint32) bool { return e%2 == 1 }
+			in, out := s.Partition(odd)
+			if !reflect.DeepEqual(in.AsSlice(), ms.Filter(odd).AsSortedSlice()) || in.Size()+out.Size() != s.Size() || !s.Filter(odd).Equals(in) {
+				t.Errorf("%d Partition got sizes: %d %d", len(a), in.Size(), out.Size())
+			}
+			if s.Any(odd) != ms.Any(odd) || s.Every(odd) != ms.Every(odd) || s.None(odd) != ms.None(odd) || s.Count(odd) != ms.Count(odd) {
+				t.Errorf("%d predicates got: %v %v %v %v", len(a), s.Any(odd), s.Every(odd), s.None(odd), s.Count(odd))
+			}
+			r := s.Clone()
+			r.Retain(odd)
+			if !r.Equals(in) {
+				t.Errorf("%d Retain got size: %d", len(a), r.Size())
+			}
+			r = s.Clone()
+			r.RemoveIf(odd)
+			if !r.Equals(out) {
+				t.Errorf("%d RemoveIf got size: %d", len(a), r.Size())
+			}
+			data, err := s.MarshalBinary()
+			r = menge.NewRoaringUInt32Set(7)
+			if err != nil || r.UnmarshalBinary(data) != nil || !r.Equals(s) {
+				t.Errorf("%d binary round trip got size: %d, error: %v", len(a), r.Size(), err)
+			}
+		}
+	}
+}
+
+func TestRoaringUInt32Set_Mutations(t *testing.T) {
+	var s menge.RoaringUInt32Set
+	if !s.IsEmpty() || s.Has(1) || s.Size() != 0 {
+		t.Errorf("zero value got: %v", &s)
+	}
+	for e := uint32(0); e < 5000; e++ {
+		s.Add(e, e+1<<16)
+	}
+	s.Optimize()
+	s.Add(1 << 17)
+	c := s.Clone()
+	for e := uint32(0); e < 5000; e += 2 {
+		s.Remove(e, e+1<<16)
+	}
+	s.Remove(1<<17, 1<<18)
+	if s.Size() != 5000 || c.Size() != 10001 || s.Has(2) || !s.Has(3) || !s.Has(1<<16+4999) || s.Has(1<<17) {
+		t.Errorf("Remove got sizes: %d %d", s.Size(), c.Size())
+	}
+	for e := uint32(1); e < 5000; e += 2 {
+		s.Remove(e)
+	}
+	if got := s.AsSlice(); len(got) != 2500 || got[0] != 1<<16+1 {
+		t.Errorf("Remove got size: %d", len(got))
+	}
+	s.Empty()
+	if !s.IsEmpty() {
+		t.Errorf("Empty got: %v", &s)
+	}
+}
+
+func TestRoaringUInt32Set_Encoding(t *testing.T) {
+	s := menge.NewRoaringUInt32Set(3, 1<<16, 1)
+	if got := fmt.Sprintf("%v %+v %#v", s, s, s); got != "{1 3 65536} {1 3 65536} (size 3) menge.NewRoaringUInt32Set(0x1, 0x3, 0x10000)" {
+		t.Errorf("Format got: %s", got)
+	}
+	data, err := json.Marshal(s)
+	if err != nil || string(data) != `[1,3,65536]` {
+		t.Errorf("MarshalJSON got: %s %v", data, err)
+	}
+	u := menge.NewRoaringUInt32Set(5)
+	if err := json.Unmarshal([]byte(`[2,1,2]`), u); err != nil || !reflect.DeepEqual(u.AsSlice(), []uint32{1, 2}) {
+		t.Errorf("UnmarshalJSON got: %v %v", u, err)
+	}
+	if err := json.Unmarshal([]byte(`[-1]`), u); err == nil {
+		t.Errorf("UnmarshalJSON of out of range element got: %v", u)
+	}
+}
+
+// TestRoaringUInt32Set_Binary checks the encoding against the Roaring format
+// specification.
+func TestRoaringUInt32Set_Binary(t *testing.T) {
+	s := menge.NewRoaringUInt32Set(1, 2, 3)
+	data, _ := s.MarshalBinary()
+	if got, want := hex.EncodeToString(data), "3a300000010000000000020010000000010002000300"; got != want {
+		t.Errorf("MarshalBinary got: %s, want: %s", got, want)
+	}
+	s.Add(4, 5, 6, 7, 8, 9, 10)
+	s.Optimize()
+	data, _ = s.MarshalBinary()
+	if got, want := hex.EncodeToString(data), "3b3000000100000900010001000900"; got != want {
+		t.Errorf("MarshalBinary after Optimize got: %s, want: %s", got, want)
+	}
+	for _, c := range []string{
+		"",
+		"3a300000",
+		"39300000",
+		"3a30000001000100",
+		"3a30000001000000000002001000000001000200",
+		"3a300000010000000000020010000000010002000300" + "0000",
+		"3a300000010000000000020010000000010003000200",
+		"3a3000000200000000000000000000001400000016000000" + "01000100",
+		"3b3000000100000100010001000200",
+		"3b30000001000002000100ffff0200",
+	} {
+		data, _ := hex.DecodeString(c)
+		u := menge.NewRoaringUInt32Set(7)
+		if err := u.UnmarshalBinary(data); err == nil || !u.Equals(menge.NewRoaringUInt32Set(7)) {
+			t.Errorf("UnmarshalBinary of %s got: %v", c, u)
+		}
+	}
+}
+
+func TestRoaringUInt64Set(t *testing.T) {
+	sets := [][]uint64{{}, {1, 2}, {1, 1 << 32, 1<<33 + 1<<16, 1<<64 - 1}, {2, 1 << 32, 1<<33 + 3}}
+	for _, a := range sets {
+		for _, b := range sets {
+			s, u := menge.NewRoaringUInt64Set(a...), menge.NewRoaringUInt64Set(b...)
+			ms, mu := menge.NewSet(a...), menge.NewSet(b...)
+			if got, want := s.Equals(u), ms.Equals(mu); got != want {
+				t.Errorf("%v Equals %v got: %v", a, b, got)
+			}
+			for _, op := range []struct {
+				name string
+				f    func(s, u *menge.RoaringUInt64Set) *menge.RoaringUInt64Set
+				g    func(s, u menge.Set[uint64]) menge.Set[uint64]
+			}{
+				{"Union", (*menge.RoaringUInt64Set).Union, menge.Set[uint64].Union},
+				{"Intersection", (*menge.RoaringUInt64Set).Intersection, menge.Set[uint64].Intersection},
+				{"Difference", (*menge.RoaringUInt64Set).Difference, menge.Set[uint64].Difference},
+				{"SymmetricDifference", (*menge.RoaringUInt64Set).SymmetricDifference, menge.Set[uint64].SymmetricDifference},
+			} {
+				if got, want := op.f(s, u).AsSlice(), op.g(ms, mu).AsSortedSlice(); !reflect.DeepEqual(got, want) {
+					t.Errorf("%v %s %v got: %v", a, op.name, b, got)
+				}
+			}
+			for _, op := range []struct {
+				name string
+				f    func(s, u *menge.RoaringUInt64Set) bool
+				g    func(s, u menge.Set[uint64]) bool
+			}{
+				{"IsSubsetOf", (*menge.RoaringUInt64Set).IsSubsetOf, menge.Set[uint64].IsSubsetOf},
+				{"IsProperSupersetOf", (*menge.RoaringUInt64Set).IsProperSupersetOf, menge.Set[uint64].IsProperSupersetOf},
+				{"IsDisjointFrom", (*menge.RoaringUInt64Set).IsDisjointFrom, menge.Set[uint64].IsDisjointFrom},
+			} {
+				if got, want := op.f(s, u), op.g(ms, mu); got != want {
+					t.Errorf("%v %s %v got: %v", a, op.name, b, got)
+				}
+			}
+			if got, want := s.Jaccard(u), ms.Jaccard(mu); got != want {
+				t.Errorf("%v Jaccard %v got: %v", a, b, got)
+			}
+		}
+		s, ms := menge.NewRoaringUInt64Set(a...), menge.NewSet(a...)
+		if s.String() != ms.String() || !reflect.DeepEqual(s.AsSortedSliceDesc(), ms.AsSortedSliceDesc()) {
+			t.Errorf("%v got: %v", a, s)
+		}
+		if !reflect.DeepEqual(s.ToUInt64Set(), menge.UInt64Set(ms)) || !menge.FromUInt64Set(menge.UInt64Set(ms)).Equals(s) {
+			t.Errorf("%v conversion got: %v", a, s)
+		}
+		s.Remove(a...)
+		if !s.IsEmpty() {
+			t.Errorf("%v Remove got: %v", a, s)
+		}
+	}
+}
+
+func TestRoaringUInt64Set_Binary(t *testing.T) {
+	s := menge.NewRoaringUInt64Set(1, 1<<32+2)
+	data, _ := s.MarshalBinary()
+	want := "0200000000000000" +
+		"00000000" + "3a300000010000000000000010000000" + "0100" +
+		"01000000" + "3a300000010000000000000010000000" + "0200"
+	if got := hex.EncodeToString(data); got != want {
+		t.Errorf("MarshalBinary got: %s, want: %s", got, want)
+	}
+	u := menge.NewRoaringUInt64Set(7)
+	if err := u.UnmarshalBinary(data); err != nil || !u.Equals(s) {
+		t.Errorf("UnmarshalBinary got: %v %v", u, err)
+	}
+	empty := "0200000000000000" + "00000000" + "3a30000000000000" + "01000000" + "3a30000000000000"
+	data, _ = hex.DecodeString(empty)
+	if err := u.UnmarshalBinary(data); err != nil || !u.IsEmpty() {
+		t.Errorf("UnmarshalBinary of empty buckets got: %v %v", u, err)
+	}
+	for _, c := range []string{
+		"", "0100000000000000", want[:len(want)-2], want + "00", "ffffffffffffffff",
+		"0200000000000000" + "05000000" + "3a30000000000000" + "03000000" + "3a300000010000000000000010000000" + "0100",
+	} {
+		data, _ := hex.DecodeString(c)
+		u := menge.NewRoaringUInt64Set(7)
+		if err := u.UnmarshalBinary(data); err == nil || !u.Equals(menge.NewRoaringUInt64Set(7)) {
+			t.Errorf("UnmarshalBinary of %s got: %v", c, u)
+		}
+	}
+}
+
+func FuzzRoaringUInt32Set_Binary(f *testing.F) {
+	f.Add([]byte{})
+	for _, h := range []string{"3a300000010000000000020010000000010002000300", "3b3000000100000900010001000900"} {
+		data, _ := hex.DecodeString(h)
+		f.Add(data)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var s menge.RoaringUInt32Set
+		if s.UnmarshalBinary(data) != nil {
+			return
+		}
+		again, err := s.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var r menge.RoaringUInt32Set
+		if err := r.UnmarshalBinary(again); err != nil || !r.Equals(&s) {
+			t.Errorf("%x got: %v, then: %v, error: %v", data, &s, &r, err)
+		}
+	})
+}
+
+func FuzzRoaringUInt64Set_Binary(f *testing.F) {
+	for _, h := range []string{
+		"0200000000000000" + "00000000" + "3a300000010000000000000010000000" + "0100" + "01000000" + "3a300000010000000000000010000000" + "0200",
+		"0200000000000000" + "00000000" + "3a30000000000000" + "01000000" + "3a30000000000000",
+	} {
+		data, _ := hex.DecodeString(h)
+		f.Add(data)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var s menge.RoaringUInt64Set
+		if s.UnmarshalBinary(data) != nil {
+			return
+		}
+		again, err := s.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var r menge.RoaringUInt64Set
+		if err := r.UnmarshalBinary(again); err != nil || !r.Equals(&s) {
+			t.Errorf("%x got: %v, then: %v, error: %v", data, &s, &r, err)
+		}
+	})
+}
+
+// BenchmarkRoaring compares a RoaringUInt32Set with a UInt32Set holding a
+// million clustered elements. The build benchmarks report the memory of the
+// sets.
+func BenchmarkRoaring(b *testing.B) {
+	var elems, others []uint32
+	for i := uint32(0); len(elems) < 1e6; i++ {
+		if i%1000 < 900 {
+			elems = append(elems, i)
+			others = append(others, i+500)
+		}
+	}
+	b.Run("Build/Map", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			menge.NewUInt32Set(elems...)
+		}
+	})
+	b.Run("Build/Roaring", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			menge.NewRoaringUInt32Set(elems...)
+		}
+	})
+	ms, mu := menge.NewUInt32Set(elems...), menge.NewUInt32Set(others...)
+	rs, ru := menge.NewRoaringUInt32Set(elems...), menge.NewRoaringUInt32Set(others...)
+	b.Run("Has/Map", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ms.Has(uint32(i))
+		}
+	})
+	b.Run("Has/Roaring", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			rs.Has(uint32(i))
+		}
+	})
+	b.Run("Intersection/Map", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ms.Intersection(mu)
+		}
+	})
+	b.Run("Intersection/Roaring", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			rs.Intersection(ru)
+		}
+	})
+	rs.Optimize()
+	b.Run("MarshalBinary/Optimized", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			rs.MarshalBinary()
+		}
+	})
+}
@@ -0,0 +1,471 @@
+package menge
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Set represents a set of elements of any comparable type.
+// The concrete set types of this package, such as IntSet and StringSet,
+// are defined over Set and can be converted to and from it at no cost.
+type Set[T comparable] map[T]struct{}
+
+// Add adds zero or more elements to the set.
+func (s Set[T]) Add(elems ...T) {
+	for _, e := range elems {
+		s[e] = struct{}{}
+	}
+}
+
+// Remove removes zero or more elements from the set.
+func (s Set[T]) Remove(elems ...T) {
+	for _, e := range elems {
+		delete(s, e)
+	}
+}
+
+// Empty empties the set.
+func (s Set[T]) Empty() {
+	for e := range s {
+		delete(s, e)
+	}
+}
+
+// Has indicates whether the set has an element.
+func (s Set[T]) Has(elem T) bool {
+	_, ok := s[elem]
+	return ok
+}
+
+// Size returns the size of the set.
+func (s Set[T]) Size() int {
+	return len(s)
+}
+
+// IsEmpty indicates whether the set is empty.
+func (s Set[T]) IsEmpty() bool {
+	return len(s) == 0
+}
+
+// Clone returns a clone of the set.
+func (s Set[T]) Clone() Set[T] {
+	c := make(Set[T], len(s))
+	for e := range s {
+		c[e] = struct{}{}
+	}
+	return c
+}
+
+// AsSlice returns an equivalent slice with no specific order of the elements.
+func (s Set[T]) AsSlice() []T {
+	return s.AppendTo(make([]T, 0, len(s)))
+}
+
+// AsSortedSlice returns an equivalent slice with the elements in their
+// natural order. See LessFunc for the definition of the order.
+func (s Set[T]) AsSortedSlice() []T {
+	a := s.AsSlice()
+	sortElems(a)
+	return a
+}
+
+// AsSortedSliceDesc returns an equivalent slice with the elements in the
+// reverse of their natural order.
+func (s Set[T]) AsSortedSliceDesc() []T {
+	a := s.AsSlice()
+	sort.Sort(sort.Reverse(SortInterface(a)))
+	return a
+}
+
+// AppendTo appends the elements of the set to dst, with no specific order,
+// and returns the extended slice. It allocates only if dst does not have
+// enough capacity.
+func (s Set[T]) AppendTo(dst []T) []T {
+	for e := range s {
+		dst = append(dst, e)
+	}
+	return dst
+}
+
+// String returns a string representation of the set,
+// with its elements in their natural order.
+func (s Set[T]) String() string {
+	a := s.AsSortedSlice()
+	b := &strings.Builder{}
+	b.Grow(len(a) * 8)
+	writeElems(b, a, "%v", " ")
+	return b.String()
+}
+
+// Format implements the fmt.Formatter interface.
+// The elements are formatted in their natural order.
+// The %v and %s verbs format the set as String does, e.g., {1 2}.
+// The %+v verb appends the size of the set, e.g., {1 2} (size 2).
+// The %#v verb formats the set as a Go expression, e.g., menge.NewSet[int](1, 2).
+// Other verbs, along with their flags, width, and precision, are applied to
+// each element, e.g., %q formats a set of strings as {"a" "b"}.
+func (s Set[T]) Format(f fmt.State, verb rune) {
+	var zero T
+	s.format(f, verb, "menge.NewSet["+reflect.TypeOf(&zero).Elem().String()+"]")
+}
+
+// Equals indicates whether s and t are equal.
+func (s Set[T]) Equals(t Set[T]) bool {
+	if len(s) != len(t) {
+		return false
+	}
+	for e := range s {
+		if _, ok := t[e]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Union returns the union of s and t.
+func (s Set[T]) Union(t Set[T]) Set[T] {
+	r := make(Set[T], len(s)+len(t))
+	for e := range s {
+		r[e] = struct{}{}
+	}
+	for e := range t {
+		r[e] = struct{}{}
+	}
+	return r
+}
+
+// Intersection returns the intersection of s and t.
+func (s Set[T]) Intersection(t Set[T]) Set[T] {
+	var small, large Set[T]
+	if len(s) <= len(t) {
+		small, large = s, t
+	} else {
+		small, large = t, s
+	}
+	r := make(Set[T], len(small))
+	for e := range small {
+		if _, ok := large[e]; ok {
+			r[e] = struct{}{}
+		}
+	}
+	return r
+}
+
+// Difference returns the difference of s and t, i.e., s - t.
+func (s Set[T]) Difference(t Set[T]) Set[T] {
+	r := make(Set[T], len(s))
+	for e := range s {
+		if _, ok := t[e]; !ok {
+			r[e] = struct{}{}
+		}
+	}
+	return r
+}
+
+// SymmetricDifference returns the symmetric difference of s and t,
+// i.e., the elements that are in either s or t but not in both.
+func (s Set[T]) SymmetricDifference(t Set[T]) Set[T] {
+	r := make(Set[T], len(s)+len(t))
+	for e := range s {
+		if _, ok := t[e]; !ok {
+			r[e] = struct{}{}
+		}
+	}
+	for e := range t {
+		if _, ok := s[e]; !ok {
+			r[e] = struct{}{}
+		}
+	}
+	return r
+}
+
+// UnionWith adds the elements of t to s, i.e., s = s ⋃ t.
+func (s Set[T]) UnionWith(t Set[T]) {
+	for e := range t {
+		s[e] = struct{}{}
+	}
+}
+
+// IntersectWith removes the elements of s that are not in t, i.e., s = s ⋂ t.
+func (s Set[T]) IntersectWith(t Set[T]) {
+	if len(t) < len(s) {
+		// Collect the common elements by iterating over the smaller set,
+		// then rebuild s; clearing a map is cheaper than looking up each of
+		// its elements in t.
+		keep := make([]T, 0, len(t))
+		for e := range t {
+			if _, ok := s[e]; ok {
+				keep = append(keep, e)
+			}
+		}
+		for e := range s {
+			delete(s, e)
+		}
+		for _, e := range keep {
+			s[e] = struct{}{}
+		}
+		return
+	}
+	for e := range s {
+		if _, ok := t[e]; !ok {
+			delete(s, e)
+		}
+	}
+}
+
+// DifferenceWith removes the elements of t from s, i.e., s = s - t.
+func (s Set[T]) DifferenceWith(t Set[T]) {
+	if len(t) <= len(s) {
+		for e := range t {
+			delete(s, e)
+		}
+		return
+	}
+	for e := range s {
+		if _, ok := t[e]; ok {
+			delete(s, e)
+		}
+	}
+}
+
+// SymmetricDifferenceWith replaces s with the symmetric difference of s and t,
+// i.e., removes the elements of t that are in s and adds those that are not.
+func (s Set[T]) SymmetricDifferenceWith(t Set[T]) {
+	for e := range t {
+		if _, ok := s[e]; ok {
+			delete(s, e)
+		} else {
+			s[e] = struct{}{}
+		}
+	}
+}
+
+// IsSubsetOf indicates whether s is a subset of t.
+func (s Set[T]) IsSubsetOf(t Set[T]) bool {
+	for e := range s {
+		if _, ok := t[e]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// IsProperSubsetOf indicates whether s is a proper subset of t.
+func (s Set[T]) IsProperSubsetOf(t Set[T]) bool {
+	for e := range s {
+		if _, ok := t[e]; !ok {
+			return false
+		}
+	}
+	return len(s) != len(t)
+}
+
+// IsSupersetOf indicates whether s is a superset of t.
+func (s Set[T]) IsSupersetOf(t Set[T]) bool {
+	for e := range t {
+		if _, ok := s[e]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// IsProperSupersetOf indicates whether s is a proper superset of t.
+func (s Set[T]) IsProperSupersetOf(t Set[T]) bool {
+	for e := range t {
+		if _, ok := s[e]; !ok {
+			return false
+		}
+	}
+	return len(s) != len(t)
+}
+
+// IsDisjointFrom indicates whether s and t are disjoint.
+func (s Set[T]) IsDisjointFrom(t Set[T]) bool {
+	var small, large Set[T]
+	if len(s) <= len(t) {
+		small, large = s, t
+	} else {
+		small, large = t, s
+	}
+	for e := range small {
+		if _, ok := large[e]; ok {
+			return false
+		}
+	}
+	return true
+}
+
+// IntersectionSize returns the size of the intersection of s and t,
+// without computing the intersection.
+func (s Set[T]) IntersectionSize(t Set[T]) int {
+	var small, large Set[T]
+	if len(s) <= len(t) {
+		small, large = s, t
+	} else {
+		small, large = t, s
+	}
+	n := 0
+	for e := range small {
+		if _, ok := large[e]; ok {
+			n++
+		}
+	}
+	return n
+}
+
+// UnionSize returns the size of the union of s and t,
+// without computing the union.
+func (s Set[T]) UnionSize(t Set[T]) int {
+	return len(s) + len(t) - s.IntersectionSize(t)
+}
+
+// DifferenceSize returns the size of the difference of s and t, i.e., s - t,
+// without computing the difference.
+func (s Set[T]) DifferenceSize(t Set[T]) int {
+	return len(s) - s.IntersectionSize(t)
+}
+
+// Jaccard returns the Jaccard index of s and t, i.e., |s ⋂ t| / |s ⋃ t|.
+// The Jaccard index of two empty sets is 1.
+func (s Set[T]) Jaccard(t Set[T]) float64 {
+	return jaccard(s.IntersectionSize(t), len(s), len(t))
+}
+
+// SorensenDice returns the Sørensen–Dice coefficient of s and t,
+// i.e., 2|s ⋂ t| / (|s| + |t|).
+// The Sørensen–Dice coefficient of two empty sets is 1.
+func (s Set[T]) SorensenDice(t Set[T]) float64 {
+	return sorensenDice(s.IntersectionSize(t), len(s), len(t))
+}
+
+// OverlapCoefficient returns the overlap coefficient of s and t,
+// i.e., |s ⋂ t| / min(|s|, |t|).
+// The overlap coefficient of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s Set[T]) OverlapCoefficient(t Set[T]) float64 {
+	return overlapCoefficient(s.IntersectionSize(t), len(s), len(t))
+}
+
+// CosineSimilarity returns the cosine similarity of s and t,
+// i.e., |s ⋂ t| / √(|s||t|).
+// The cosine similarity of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s Set[T]) CosineSimilarity(t Set[T]) float64 {
+	return cosineSimilarity(s.IntersectionSize(t), len(s), len(t))
+}
+
+// Filter returns a new set containing the elements of s for which pred
+// returns true.
+func (s Set[T]) Filter(pred func(elem T) bool) Set[T] {
+	r := make(Set[T])
+	for e := range s {
+		if pred(e) {
+			r[e] = struct{}{}
+		}
+	}
+	return r
+}
+
+// Retain removes the elements of s for which pred returns false.
+func (s Set[T]) Retain(pred func(elem T) bool) {
+	for e := range s {
+		if !pred(e) {
+			delete(s, e)
+		}
+	}
+}
+
+// RemoveIf removes the elements of s for which pred returns true.
+func (s Set[T]) RemoveIf(pred func(elem T) bool) {
+	for e := range s {
+		if pred(e) {
+			delete(s, e)
+		}
+	}
+}
+
+// Partition returns two new sets containing the elements of s for which pred
+// returns true and false, respectively.
+func (s Set[T]) Partition(pred func(elem T) bool) (in, out Set[T]) {
+	in, out = make(Set[T]), make(Set[T])
+	for e := range s {
+		if pred(e) {
+			in[e] = struct{}{}
+		} else {
+			out[e] = struct{}{}
+		}
+	}
+	return in, out
+}
+
+// Any indicates whether pred returns true for any element of s.
+// It returns false for an empty set.
+func (s Set[T]) Any(pred func(elem T) bool) bool {
+	for e := range s {
+		if pred(e) {
+			return true
+		}
+	}
+	return false
+}
+
+// Every indicates whether pred returns true for all elements of s.
+// It returns true for an empty set.
+func (s Set[T]) Every(pred func(elem T) bool) bool {
+	for e := range s {
+		if !pred(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// None indicates whether pred returns false for all elements of s.
+// It returns true for an empty set.
+func (s Set[T]) None(pred func(elem T) bool) bool {
+	return !s.Any(pred)
+}
+
+// Count returns the number of elements of s for which pred returns true.
+func (s Set[T]) Count(pred func(elem T) bool) int {
+	n := 0
+	for e := range s {
+		if pred(e) {
+			n++
+		}
+	}
+	return n
+}
+
+// NewSet returns a new Set containing zero or more elements.
+func NewSet[T comparable](elems ...T) Set[T] {
+	s := make(Set[T], len(elems))
+	s.Add(elems...)
+	return s
+}
+
+// isNaN indicates whether e is not equal to itself,
+// which is the case for NaN floats and complex numbers with NaN parts.
+func isNaN[T comparable](e T) bool {
+	return e != e
+}
+
+// withoutNaN returns s if it has no elements that are not equal to themselves,
+// or a copy of s without such elements otherwise.
+func withoutNaN[T comparable](s Set[T]) Set[T] {
+	for e := range s {
+		if isNaN(e) {
+			r := make(Set[T], len(s))
+			for e := range s {
+				if !isNaN(e) {
+					r[e] = struct{}{}
+				}
+			}
+			return r
+		}
+	}
+	return s
+}
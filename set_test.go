@@ -0,0 +1,886 @@
+package menge_test
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/soroushj/menge"
+)
+
+// set is the method set shared by Set and all concrete set types.
+type set[T comparable, S any] interface {
+	~map[T]struct{}
+	Add(elems ...T)
+	Remove(elems ...T)
+	Empty()
+	Has(elem T) bool
+	Size() int
+	IsEmpty() bool
+	Clone() S
+	AsSlice() []T
+	AsSortedSlice() []T
+	AsSortedSliceDesc() []T
+	AppendTo(dst []T) []T
+	String() string
+	Equals(t S) bool
+	Union(t S) S
+	Intersection(t S) S
+	Difference(t S) S
+	SymmetricDifference(t S) S
+	UnionWith(t S)
+	IntersectWith(t S)
+	DifferenceWith(t S)
+	SymmetricDifferenceWith(t S)
+	IsSubsetOf(t S) bool
+	IsProperSubsetOf(t S) bool
+	IsSupersetOf(t S) bool
+	IsProperSupersetOf(t S) bool
+	IsDisjointFrom(t S) bool
+	IntersectionSize(t S) int
+	UnionSize(t S) int
+	DifferenceSize(t S) int
+	Jaccard(t S) float64
+	SorensenDice(t S) float64
+	OverlapCoefficient(t S) float64
+	CosineSimilarity(t S) float64
+	Filter(pred func(elem T) bool) S
+	Retain(pred func(elem T) bool)
+	RemoveIf(pred func(elem T) bool)
+	Partition(pred func(elem T) bool) (in, out S)
+	Any(pred func(elem T) bool) bool
+	Every(pred func(elem T) bool) bool
+	None(pred func(elem T) bool) bool
+	Count(pred func(elem T) bool) int
+}
+
+type point struct {
+	x, y int
+}
+
+func TestSets(t *testing.T) {
+	t.Run("Complex128Set", suite(menge.NewComplex128Set, 1, 2, 3))
+	t.Run("Complex64Set", suite(menge.NewComplex64Set, 1, 2, 3))
+	t.Run("Float32Set", suite(menge.NewFloat32Set, 1, 2, 3))
+	t.Run("Float64Set", suite(menge.NewFloat64Set, 1, 2, 3))
+	t.Run("IntSet", suite(menge.NewIntSet, 1, 2, 3))
+	t.Run("Int16Set", suite(menge.NewInt16Set, 1, 2, 3))
+	t.Run("Int32Set", suite(menge.NewInt32Set, 1, 2, 3))
+	t.Run("Int64Set", suite(menge.NewInt64Set, 1, 2, 3))
+	t.Run("Int8Set", suite(menge.NewInt8Set, 1, 2, 3))
+	t.Run("StringSet", suite(menge.NewStringSet, "1", "2", "3"))
+	t.Run("UIntSet", suite(menge.NewUIntSet, 1, 2, 3))
+	t.Run("UInt16Set", suite(menge.NewUInt16Set, 1, 2, 3))
+	t.Run("UInt32Set", suite(menge.NewUInt32Set, 1, 2, 3))
+	t.Run("UInt64Set", suite(menge.NewUInt64Set, 1, 2, 3))
+	t.Run("UInt8Set", suite(menge.NewUInt8Set, 1, 2, 3))
+	t.Run("UIntPtrSet", suite(menge.NewUIntPtrSet, 1, 2, 3))
+	t.Run("Set[int]", suite(menge.NewSet[int], 1, 2, 3))
+	t.Run("Set[string]", suite(menge.NewSet[string], "1", "2", "3"))
+	t.Run("Set[point]", suite(menge.NewSet[point], point{1, 1}, point{2, 2}, point{3, 3}))
+}
+
+func TestFloat32Set_NaN(t *testing.T) {
+	testIgnoresNaN(t, menge.NewFloat32Set, float32(math.NaN()))
+}
+
+func TestFloat64Set_NaN(t *testing.T) {
+	testIgnoresNaN(t, menge.NewFloat64Set, math.NaN())
+}
+
+func TestComplex64Set_NaN(t *testing.T) {
+	testIgnoresNaN(t, menge.NewComplex64Set, complex(1, float32(math.NaN())))
+}
+
+func TestComplex128Set_NaN(t *testing.T) {
+	testIgnoresNaN(t, menge.NewComplex128Set, complex(math.NaN(), math.Inf(1)))
+}
+
+// suite returns a test running the shared tests against the set type S.
+// The elements a, b, and c must be in ascending order.
+func suite[T comparable, S set[T, S]](newSet func(...T) S, a, b, c T) func(*testing.T) {
+	return func(t *testing.T) {
+		t.Run("New", func(t *testing.T) { testNew(t, newSet, a, b) })
+		t.Run("Add", func(t *testing.T) { testAdd(t, newSet, a, b, c) })
+		t.Run("Remove", func(t *testing.T) { testRemove(t, newSet, a, b, c) })
+		t.Run("Empty", func(t *testing.T) { testEmpty(t, newSet, a, b) })
+		t.Run("Has", func(t *testing.T) { testHas(t, newSet, a, b) })
+		t.Run("Size", func(t *testing.T) { testSize(t, newSet, a, b) })
+		t.Run("IsEmpty", func(t *testing.T) { testIsEmpty(t, newSet, a, b) })
+		t.Run("Clone", func(t *testing.T) { testClone(t, newSet, a, b) })
+		t.Run("AsSlice", func(t *testing.T) { testAsSlice(t, newSet, a, b) })
+		t.Run("AsSortedSlice", func(t *testing.T) { testAsSortedSlice(t, newSet, a, b, c) })
+		t.Run("AppendTo", func(t *testing.T) { testAppendTo(t, newSet, a, b, c) })
+		t.Run("String", func(t *testing.T) { testString(t, newSet, a, b) })
+		t.Run("Equals", func(t *testing.T) { testEquals(t, newSet, a, b) })
+		t.Run("Union", func(t *testing.T) { testUnion(t, newSet, a, b) })
+		t.Run("Intersection", func(t *testing.T) { testIntersection(t, newSet, a, b) })
+		t.Run("Difference", func(t *testing.T) { testDifference(t, newSet, a, b) })
+		t.Run("SymmetricDifference", func(t *testing.T) { testSymmetricDifference(t, newSet, a, b, c) })
+		t.Run("UnionWith", func(t *testing.T) { testUnionWith(t, newSet, a, b, c) })
+		t.Run("IntersectWith", func(t *testing.T) { testIntersectWith(t, newSet, a, b, c) })
+		t.Run("DifferenceWith", func(t *testing.T) { testDifferenceWith(t, newSet, a, b, c) })
+		t.Run("SymmetricDifferenceWith", func(t *testing.T) { testSymmetricDifferenceWith(t, newSet, a, b, c) })
+		t.Run("IsSubsetOf", func(t *testing.T) { testIsSubsetOf(t, newSet, a, b) })
+		t.Run("IsProperSubsetOf", func(t *testing.T) { testIsProperSubsetOf(t, newSet, a, b) })
+		t.Run("IsSupersetOf", func(t *testing.T) { testIsSupersetOf(t, newSet, a, b) })
+		t.Run("IsProperSupersetOf", func(t *testing.T) { testIsProperSupersetOf(t, newSet, a, b) })
+		t.Run("IsDisjointFrom", func(t *testing.T) { testIsDisjointFrom(t, newSet, a, b, c) })
+		t.Run("IntersectionSize", func(t *testing.T) { testIntersectionSize(t, newSet, a, b, c) })
+		t.Run("UnionSize", func(t *testing.T) { testUnionSize(t, newSet, a, b, c) })
+		t.Run("DifferenceSize", func(t *testing.T) { testDifferenceSize(t, newSet, a, b, c) })
+		t.Run("Similarity", func(t *testing.T) { testSimilarity(t, newSet, a, b, c) })
+		t.Run("Filter", func(t *testing.T) { testFilter(t, newSet, a, b, c) })
+		t.Run("Retain", func(t *testing.T) { testRetain(t, newSet, a, b, c) })
+		t.Run("RemoveIf", func(t *testing.T) { testRemoveIf(t, newSet, a, b, c) })
+		t.Run("Partition", func(t *testing.T) { testPartition(t, newSet, a, b, c) })
+		t.Run("Predicates", func(t *testing.T) { testPredicates(t, newSet, a, b, c) })
+	}
+}
+
+func testNew[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b T) {
+	cases := []struct {
+		arg  []T
+		want S
+	}{
+		{[]T{}, S{}},
+		{[]T{a, a}, S{a: struct{}{}}},
+		{[]T{a, b}, S{a: struct{}{}, b: struct{}{}}},
+	}
+	for _, c := range cases {
+		got := n(c.arg...)
+		if !got.Equals(c.want) {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testAdd[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b, c T) {
+	cases := []struct {
+		set  S
+		arg  []T
+		want S
+	}{
+		{n(), []T{}, n()},
+		{n(), []T{a, a}, n(a)},
+		{n(), []T{a, b}, n(a, b)},
+		{n(a), []T{}, n(a)},
+		{n(a), []T{a, a}, n(a)},
+		{n(a), []T{b, c}, n(a, b, c)},
+	}
+	for _, c := range cases {
+		got := c.set.Clone()
+		got.Add(c.arg...)
+		if !got.Equals(c.want) {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testRemove[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b, c T) {
+	cases := []struct {
+		set  S
+		arg  []T
+		want S
+	}{
+		{n(), []T{}, n()},
+		{n(a), []T{a, a}, n()},
+		{n(a, b), []T{a, b}, n()},
+		{n(a), []T{}, n(a)},
+		{n(a), []T{a, a}, n()},
+		{n(a, b), []T{c}, n(a, b)},
+		{n(a, b, c), []T{b, c}, n(a)},
+	}
+	for _, c := range cases {
+		got := c.set.Clone()
+		got.Remove(c.arg...)
+		if !got.Equals(c.want) {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testEmpty[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b T) {
+	cases := []struct {
+		set  S
+		want S
+	}{
+		{n(), n()},
+		{n(a, b), n()},
+	}
+	for _, c := range cases {
+		got := c.set.Clone()
+		got.Empty()
+		if !got.Equals(c.want) {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testHas[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b T) {
+	cases := []struct {
+		set  S
+		arg  T
+		want bool
+	}{
+		{n(), a, false},
+		{n(b), a, false},
+		{n(a), a, true},
+		{n(a, b), a, true},
+	}
+	for _, c := range cases {
+		got := c.set.Has(c.arg)
+		if got != c.want {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testSize[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b T) {
+	cases := []struct {
+		set  S
+		want int
+	}{
+		{n(), 0},
+		{n(a, b), 2},
+	}
+	for _, c := range cases {
+		got := c.set.Size()
+		if got != c.want {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testIsEmpty[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b T) {
+	cases := []struct {
+		set  S
+		want bool
+	}{
+		{n(), true},
+		{n(a, b), false},
+	}
+	for _, c := range cases {
+		got := c.set.IsEmpty()
+		if got != c.want {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testClone[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b T) {
+	cases := []struct {
+		set  S
+		want S
+	}{
+		{n(), n()},
+		{n(a, b), n(a, b)},
+	}
+	for _, c := range cases {
+		got := c.set.Clone()
+		if !got.Equals(c.want) {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testAsSlice[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b T) {
+	cases := []struct {
+		set  S
+		want []T
+	}{
+		{n(), []T{}},
+		{n(a, b), []T{a, b}},
+	}
+	for _, c := range cases {
+		got := c.set.AsSlice()
+		if len(got) != len(c.want) || !n(got...).Equals(n(c.want...)) {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testAsSortedSlice[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b, c T) {
+	cases := []struct {
+		set      S
+		want     []T
+		wantDesc []T
+	}{
+		{n(), []T{}, []T{}},
+		{n(a), []T{a}, []T{a}},
+		{n(b, a), []T{a, b}, []T{b, a}},
+		{n(c, a, b), []T{a, b, c}, []T{c, b, a}},
+	}
+	for _, c := range cases {
+		got, gotDesc := c.set.AsSortedSlice(), c.set.AsSortedSliceDesc()
+		if !reflect.DeepEqual(got, c.want) || !reflect.DeepEqual(gotDesc, c.wantDesc) {
+			t.Errorf("case: %v got: %v %v", c, got, gotDesc)
+		}
+	}
+}
+
+func testAppendTo[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b, c T) {
+	cases := []struct {
+		set  S
+		dst  []T
+		want []T
+	}{
+		{n(), nil, nil},
+		{n(), []T{a}, []T{a}},
+		{n(b, c), nil, []T{b, c}},
+		{n(b, c), []T{a}, []T{a, b, c}},
+	}
+	for _, c := range cases {
+		got := c.set.AppendTo(c.dst)
+		if len(got) != len(c.want) || !n(got...).Equals(n(c.want...)) || len(c.dst) != 0 && got[0] != c.dst[0] {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+	s := n(a, b)
+	buf := make([]T, 0, 2)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = s.AppendTo(buf[:0])
+	})
+	if allocs != 0 {
+		t.Errorf("got %v allocations, want 0", allocs)
+	}
+}
+
+func testString[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b T) {
+	sa, sb := fmt.Sprint(a), fmt.Sprint(b)
+	cases := []struct {
+		set  S
+		want string
+	}{
+		{n(), "{}"},
+		{n(a), "{" + sa + "}"},
+		{n(a, b), "{" + sa + " " + sb + "}"},
+		{n(b, a), "{" + sa + " " + sb + "}"},
+	}
+	for _, c := range cases {
+		got := c.set.String()
+		if got != c.want {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testEquals[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b T) {
+	cases := []struct {
+		set  S
+		arg  S
+		want bool
+	}{
+		{n(), n(), true},
+		{n(a, b), n(b, a), true},
+		{n(a, b), n(a), false},
+		{n(a), n(a, b), false},
+		{n(a), n(b), false},
+	}
+	for _, c := range cases {
+		got := c.set.Equals(c.arg)
+		if got != c.want {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testUnion[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b T) {
+	cases := []struct {
+		set  S
+		arg  S
+		want S
+	}{
+		{n(), n(), n()},
+		{n(a), n(a), n(a)},
+		{n(a), n(b), n(a, b)},
+		{n(a), n(a, b), n(a, b)},
+		{n(a, b), n(a), n(a, b)},
+	}
+	for _, c := range cases {
+		got := c.set.Union(c.arg)
+		if !got.Equals(c.want) {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testIntersection[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b T) {
+	cases := []struct {
+		set  S
+		arg  S
+		want S
+	}{
+		{n(), n(), n()},
+		{n(a), n(a), n(a)},
+		{n(a), n(b), n()},
+		{n(a), n(a, b), n(a)},
+		{n(a, b), n(a), n(a)},
+	}
+	for _, c := range cases {
+		got := c.set.Intersection(c.arg)
+		if !got.Equals(c.want) {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testDifference[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b T) {
+	cases := []struct {
+		set  S
+		arg  S
+		want S
+	}{
+		{n(), n(), n()},
+		{n(a), n(a), n()},
+		{n(a), n(b), n(a)},
+		{n(a), n(a, b), n()},
+		{n(a, b), n(a), n(b)},
+	}
+	for _, c := range cases {
+		got := c.set.Difference(c.arg)
+		if !got.Equals(c.want) {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testSymmetricDifference[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b, c T) {
+	cases := []struct {
+		set  S
+		arg  S
+		want S
+	}{
+		{n(), n(), n()},
+		{n(a), n(a), n()},
+		{n(a), n(b), n(a, b)},
+		{n(a), n(a, b), n(b)},
+		{n(a, b), n(a), n(b)},
+		{n(a, b), n(b, c), n(a, c)},
+	}
+	for _, c := range cases {
+		got := c.set.SymmetricDifference(c.arg)
+		if !got.Equals(c.want) {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testUnionWith[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b, c T) {
+	cases := []struct {
+		set  S
+		arg  S
+		want S
+	}{
+		{n(), n(), n()},
+		{n(a), n(a), n(a)},
+		{n(a), n(b), n(a, b)},
+		{n(a), n(a, b), n(a, b)},
+		{n(a, b), n(a), n(a, b)},
+		{n(a, b), n(b, c), n(a, b, c)},
+	}
+	for _, c := range cases {
+		got := c.set.Clone()
+		got.UnionWith(c.arg)
+		if !got.Equals(c.want) {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testIntersectWith[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b, c T) {
+	cases := []struct {
+		set  S
+		arg  S
+		want S
+	}{
+		{n(), n(), n()},
+		{n(a), n(a), n(a)},
+		{n(a), n(b), n()},
+		{n(a), n(a, b), n(a)},
+		{n(a, b), n(a), n(a)},
+		{n(a, b, c), n(b), n(b)},
+		{n(a, b), n(b, c), n(b)},
+		{n(a, b, c), n(), n()},
+	}
+	for _, c := range cases {
+		got := c.set.Clone()
+		got.IntersectWith(c.arg)
+		if !got.Equals(c.want) {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testDifferenceWith[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b, c T) {
+	cases := []struct {
+		set  S
+		arg  S
+		want S
+	}{
+		{n(), n(), n()},
+		{n(a), n(a), n()},
+		{n(a), n(b), n(a)},
+		{n(a), n(a, b), n()},
+		{n(a, b), n(a), n(b)},
+		{n(a, b, c), n(b), n(a, c)},
+		{n(a), n(b, c), n(a)},
+	}
+	for _, c := range cases {
+		got := c.set.Clone()
+		got.DifferenceWith(c.arg)
+		if !got.Equals(c.want) {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testSymmetricDifferenceWith[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b, c T) {
+	cases := []struct {
+		set  S
+		arg  S
+		want S
+	}{
+		{n(), n(), n()},
+		{n(a), n(a), n()},
+		{n(a), n(b), n(a, b)},
+		{n(a), n(a, b), n(b)},
+		{n(a, b), n(a), n(b)},
+		{n(a, b), n(b, c), n(a, c)},
+	}
+	for _, c := range cases {
+		got := c.set.Clone()
+		got.SymmetricDifferenceWith(c.arg)
+		if !got.Equals(c.want) {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+	s := n(a, b)
+	s.SymmetricDifferenceWith(s)
+	if !s.IsEmpty() {
+		t.Errorf("with itself got: %v", s)
+	}
+}
+
+func testIsSubsetOf[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b T) {
+	cases := []struct {
+		set  S
+		arg  S
+		want bool
+	}{
+		{n(), n(), true},
+		{n(a), n(a), true},
+		{n(a), n(a, b), true},
+		{n(a, b), n(a), false},
+	}
+	for _, c := range cases {
+		got := c.set.IsSubsetOf(c.arg)
+		if got != c.want {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testIsProperSubsetOf[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b T) {
+	cases := []struct {
+		set  S
+		arg  S
+		want bool
+	}{
+		{n(), n(), false},
+		{n(a), n(a), false},
+		{n(a), n(a, b), true},
+		{n(a, b), n(a), false},
+	}
+	for _, c := range cases {
+		got := c.set.IsProperSubsetOf(c.arg)
+		if got != c.want {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testIsSupersetOf[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b T) {
+	cases := []struct {
+		set  S
+		arg  S
+		want bool
+	}{
+		{n(), n(), true},
+		{n(a), n(a), true},
+		{n(a), n(a, b), false},
+		{n(a, b), n(a), true},
+	}
+	for _, c := range cases {
+		got := c.set.IsSupersetOf(c.arg)
+		if got != c.want {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testIsProperSupersetOf[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b T) {
+	cases := []struct {
+		set  S
+		arg  S
+		want bool
+	}{
+		{n(), n(), false},
+		{n(a), n(a), false},
+		{n(a), n(a, b), false},
+		{n(a, b), n(a), true},
+	}
+	for _, c := range cases {
+		got := c.set.IsProperSupersetOf(c.arg)
+		if got != c.want {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testIsDisjointFrom[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b, c T) {
+	cases := []struct {
+		set  S
+		arg  S
+		want bool
+	}{
+		{n(), n(), true},
+		{n(a), n(a), false},
+		{n(a), n(b, c), true},
+		{n(a, b), n(c), true},
+		{n(a), n(a, b), false},
+		{n(a, b), n(a), false},
+	}
+	for _, c := range cases {
+		got := c.set.IsDisjointFrom(c.arg)
+		if got != c.want {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testIntersectionSize[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b, c T) {
+	cases := []struct {
+		set  S
+		arg  S
+		want int
+	}{
+		{n(), n(), 0},
+		{n(a), n(), 0},
+		{n(a), n(a), 1},
+		{n(a), n(b, c), 0},
+		{n(a, b), n(b, c), 1},
+		{n(a, b, c), n(a, c), 2},
+	}
+	for _, c := range cases {
+		got := c.set.IntersectionSize(c.arg)
+		if got != c.want {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testUnionSize[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b, c T) {
+	cases := []struct {
+		set  S
+		arg  S
+		want int
+	}{
+		{n(), n(), 0},
+		{n(a), n(), 1},
+		{n(a), n(a), 1},
+		{n(a), n(b, c), 3},
+		{n(a, b), n(b, c), 3},
+		{n(a, b, c), n(a, c), 3},
+	}
+	for _, c := range cases {
+		got := c.set.UnionSize(c.arg)
+		if got != c.want {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testDifferenceSize[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b, c T) {
+	cases := []struct {
+		set  S
+		arg  S
+		want int
+	}{
+		{n(), n(), 0},
+		{n(), n(a), 0},
+		{n(a), n(), 1},
+		{n(a), n(a), 0},
+		{n(a, b), n(b, c), 1},
+		{n(a, b, c), n(a), 2},
+	}
+	for _, c := range cases {
+		got := c.set.DifferenceSize(c.arg)
+		if got != c.want {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testSimilarity[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b, c T) {
+	cases := []struct {
+		set                            S
+		arg                            S
+		jaccard, dice, overlap, cosine float64
+	}{
+		{n(), n(), 1, 1, 1, 1},
+		{n(a), n(), 0, 0, 0, 0},
+		{n(), n(a), 0, 0, 0, 0},
+		{n(a), n(a), 1, 1, 1, 1},
+		{n(a), n(b, c), 0, 0, 0, 0},
+		{n(a, b), n(b, c), 1.0 / 3, 0.5, 0.5, 0.5},
+		{n(a), n(a, b, c), 1.0 / 3, 0.5, 1, 1 / math.Sqrt(3)},
+	}
+	for _, c := range cases {
+		got := []float64{
+			c.set.Jaccard(c.arg),
+			c.set.SorensenDice(c.arg),
+			c.set.OverlapCoefficient(c.arg),
+			c.set.CosineSimilarity(c.arg),
+		}
+		want := []float64{c.jaccard, c.dice, c.overlap, c.cosine}
+		for i := range got {
+			if math.Abs(got[i]-want[i]) > 1e-12 {
+				t.Errorf("case: %v got: %v", c, got)
+				break
+			}
+		}
+	}
+}
+
+// notEqual returns a predicate that reports whether an element is not e.
+func notEqual[T comparable](e T) func(T) bool {
+	return func(x T) bool { return x != e }
+}
+
+func testFilter[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b, c T) {
+	cases := []struct {
+		set  S
+		want S
+	}{
+		{n(), n()},
+		{n(a), n(a)},
+		{n(b), n()},
+		{n(a, b), n(a)},
+		{n(a, b, c), n(a, c)},
+	}
+	for _, c := range cases {
+		orig := c.set.Clone()
+		got := c.set.Filter(notEqual(b))
+		if !got.Equals(c.want) || !c.set.Equals(orig) {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func testRetain[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b, c T) {
+	cases := []struct {
+		set  S
+		want S
+	}{
+		{n(), n()},
+		{n(a), n(a)},
+		{n(b), n()},
+		{n(a, b), n(a)},
+		{n(a, b, c), n(a, c)},
+	}
+	for _, c := range cases {
+		c.set.Retain(notEqual(b))
+		if !c.set.Equals(c.want) {
+			t.Errorf("case: %v got: %v", c, c.set)
+		}
+	}
+}
+
+func testRemoveIf[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b, c T) {
+	cases := []struct {
+		set  S
+		want S
+	}{
+		{n(), n()},
+		{n(a), n()},
+		{n(b), n(b)},
+		{n(a, b), n(b)},
+		{n(a, b, c), n(b)},
+	}
+	for _, c := range cases {
+		c.set.RemoveIf(notEqual(b))
+		if !c.set.Equals(c.want) {
+			t.Errorf("case: %v got: %v", c, c.set)
+		}
+	}
+}
+
+func testPartition[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b, c T) {
+	cases := []struct {
+		set S
+		in  S
+		out S
+	}{
+		{n(), n(), n()},
+		{n(a), n(a), n()},
+		{n(b), n(), n(b)},
+		{n(a, b, c), n(a, c), n(b)},
+	}
+	for _, c := range cases {
+		in, out := c.set.Partition(notEqual(b))
+		if !in.Equals(c.in) || !out.Equals(c.out) {
+			t.Errorf("case: %v got: %v %v", c, in, out)
+		}
+	}
+}
+
+func testPredicates[T comparable, S set[T, S]](t *testing.T, n func(...T) S, a, b, c T) {
+	cases := []struct {
+		set              S
+		any, every, none bool
+		count            int
+	}{
+		{n(), false, true, true, 0},
+		{n(a), true, true, false, 1},
+		{n(b), false, false, true, 0},
+		{n(a, b), true, false, false, 1},
+		{n(a, b, c), true, false, false, 2},
+	}
+	for _, c := range cases {
+		pred := notEqual(b)
+		got := []interface{}{c.set.Any(pred), c.set.Every(pred), c.set.None(pred), c.set.Count(pred)}
+		want := []interface{}{c.any, c.every, c.none, c.count}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+}
+
+func TestSet_SizesDoNotAllocate(t *testing.T) {
+	s, u := menge.NewIntSet(1, 2, 3), menge.NewIntSet(2, 3, 4)
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = s.IntersectionSize(u)
+		_ = s.UnionSize(u)
+		_ = s.DifferenceSize(u)
+		_ = s.Jaccard(u)
+		_ = s.SorensenDice(u)
+		_ = s.OverlapCoefficient(u)
+		_ = s.CosineSimilarity(u)
+	})
+	if allocs != 0 {
+		t.Errorf("got %v allocations, want 0", allocs)
+	}
+}
+
+func testIgnoresNaN[T float32 | float64 | complex64 | complex128, S set[T, S]](t *testing.T, n func(...T) S, nan T) {
+	if got := n(nan); !got.IsEmpty() {
+		t.Errorf("new with NaN got: %v", got)
+	}
+	got := n(1)
+	got.Add(nan)
+	if !got.Equals(n(1)) {
+		t.Errorf("add NaN got: %v", got)
+	}
+}
@@ -0,0 +1,719 @@
+package menge
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"runtime"
+	"sort"
+	"sync"
+	"unsafe"
+)
+
+// ShardedSet represents a set of integers or strings that is safe for
+// concurrent use by multiple goroutines and scales with the number of
+// goroutines. The elements are hash-partitioned into shards, each protected
+// by its own lock, so that operations on different shards do not contend.
+// Operations that involve the whole set, such as Size and Snapshot, lock all
+// shards and observe a consistent state of the set.
+// The zero value is an empty set ready to use.
+// A ShardedSet must not be copied after first use.
+type ShardedSet[T Integer | ~string] struct {
+	once   sync.Once
+	n      int
+	shards []shard[T]
+	hash   func(T) uint64
+}
+
+// shard is a part of a ShardedSet.
+type shard[T comparable] struct {
+	mu sync.RWMutex
+	m  Set[T]
+	// Avoid false sharing between adjacent shards.
+	_ [64]byte
+}
+
+// init initializes the set, if not initialized yet.
+func (s *ShardedSet[T]) init() {
+	s.once.Do(func() {
+		n := s.n
+		if n <= 0 {
+			n = 4 * runtime.GOMAXPROCS(0)
+		}
+		// Round up to a power of two, so that a shard can be selected by masking.
+		p := 1
+		for p < n {
+			p <<= 1
+		}
+		s.n = p
+		s.shards = make([]shard[T], p)
+		for i := range s.shards {
+			s.shards[i].m = Set[T]{}
+		}
+		s.hash = shardHash[T]()
+	})
+}
+
+// shard returns the shard of an element.
+func (s *ShardedSet[T]) shard(elem T) *shard[T] {
+	s.init()
+	return &s.shards[s.hash(elem)&uint64(s.n-1)]
+}
+
+// rlockAll locks all shards for reading.
+// Shards are always locked in the same order to avoid deadlocks.
+func (s *ShardedSet[T]) rlockAll() {
+	s.init()
+	for i := range s.shards {
+		s.shards[i].mu.RLock()
+	}
+}
+
+// runlockAll undoes a single rlockAll call.
+func (s *ShardedSet[T]) runlockAll() {
+	for i := range s.shards {
+		s.shards[i].mu.RUnlock()
+	}
+}
+
+// lockAll locks all shards for writing.
+// Shards are always locked in the same order to avoid deadlocks.
+func (s *ShardedSet[T]) lockAll() {
+	s.init()
+	for i := range s.shards {
+		s.shards[i].mu.Lock()
+	}
+}
+
+// unlockAll undoes a single lockAll call.
+func (s *ShardedSet[T]) unlockAll() {
+	for i := range s.shards {
+		s.shards[i].mu.Unlock()
+	}
+}
+
+// Shards returns the number of shards of the set.
+func (s *ShardedSet[T]) Shards() int {
+	s.init()
+	return s.n
+}
+
+// Add adds zero or more elements to the set.
+func (s *ShardedSet[T]) Add(elems ...T) {
+	for _, e := range elems {
+		sh := s.shard(e)
+		sh.mu.Lock()
+		sh.m[e] = struct{}{}
+		sh.mu.Unlock()
+	}
+}
+
+// AddIfAbsent adds an element to the set if it is absent
+// and indicates whether it was added.
+func (s *ShardedSet[T]) AddIfAbsent(elem T) bool {
+	sh := s.shard(elem)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	if sh.m.Has(elem) {
+		return false
+	}
+	sh.m[elem] = struct{}{}
+	return true
+}
+
+// Remove removes zero or more elements from the set.
+func (s *ShardedSet[T]) Remove(elems ...T) {
+	for _, e := range elems {
+		sh := s.shard(e)
+		sh.mu.Lock()
+		delete(sh.m, e)
+		sh.mu.Unlock()
+	}
+}
+
+// RemoveIfPresent removes an element from the set if it is present
+// and indicates whether it was removed.
+func (s *ShardedSet[T]) RemoveIfPresent(elem T) bool {
+	sh := s.shard(elem)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	if !sh.m.Has(elem) {
+		return false
+	}
+	delete(sh.m, elem)
+	return true
+}
+
+// Empty empties the set.
+func (s *ShardedSet[T]) Empty() {
+	s.init()
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.Lock()
+		sh.m = Set[T]{}
+		sh.mu.Unlock()
+	}
+}
+
+// Has indicates whether the set has an element.
+func (s *ShardedSet[T]) Has(elem T) bool {
+	sh := s.shard(elem)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	return sh.m.Has(elem)
+}
+
+// Size returns the size of the set.
+func (s *ShardedSet[T]) Size() int {
+	s.rlockAll()
+	defer s.runlockAll()
+	return s.size()
+}
+
+// size returns the size of the set. The caller must hold a lock on all shards.
+func (s *ShardedSet[T]) size() int {
+	n := 0
+	for i := range s.shards {
+		n += len(s.shards[i].m)
+	}
+	return n
+}
+
+// IsEmpty indicates whether the set is empty.
+func (s *ShardedSet[T]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+// Clone returns a clone of the set, with the same number of shards.
+func (s *ShardedSet[T]) Clone() *ShardedSet[T] {
+	return s.from(s.Snapshot())
+}
+
+// Snapshot returns a consistent copy of the elements of the set.
+func (s *ShardedSet[T]) Snapshot() Set[T] {
+	s.rlockAll()
+	defer s.runlockAll()
+	r := make(Set[T], s.size())
+	for i := range s.shards {
+		for e := range s.shards[i].m {
+			r[e] = struct{}{}
+		}
+	}
+	return r
+}
+
+// Range calls f for each element of a consistent snapshot of the set,
+// in no specific order, until f returns false.
+func (s *ShardedSet[T]) Range(f func(elem T) bool) {
+	for e := range s.Snapshot() {
+		if !f(e) {
+			return
+		}
+	}
+}
+
+// AsSlice returns an equivalent slice with no specific order of the elements.
+func (s *ShardedSet[T]) AsSlice() []T {
+	s.rlockAll()
+	defer s.runlockAll()
+	return s.appendTo(make([]T, 0, s.size()))
+}
+
+// AsSortedSlice returns an equivalent slice with the elements in their
+// natural order. See LessFunc for the definition of the order.
+func (s *ShardedSet[T]) AsSortedSlice() []T {
+	a := s.AsSlice()
+	sortElems(a)
+	return a
+}
+
+// AsSortedSliceDesc returns an equivalent slice with the elements in the
+// reverse of their natural order.
+func (s *ShardedSet[T]) AsSortedSliceDesc() []T {
+	a := s.AsSlice()
+	sort.Sort(sort.Reverse(SortInterface(a)))
+	return a
+}
+
+// AppendTo appends the elements of the set to dst, with no specific order,
+// and returns the extended slice. It allocates only if dst does not have
+// enough capacity.
+func (s *ShardedSet[T]) AppendTo(dst []T) []T {
+	s.rlockAll()
+	defer s.runlockAll()
+	return s.appendTo(dst)
+}
+
+// appendTo implements AppendTo. The caller must hold a lock on all shards.
+func (s *ShardedSet[T]) appendTo(dst []T) []T {
+	for i := range s.shards {
+		dst = s.shards[i].m.AppendTo(dst)
+	}
+	return dst
+}
+
+// String returns a string representation of the set,
+// with its elements in their natural order.
+func (s *ShardedSet[T]) String() string {
+	return s.Snapshot().String()
+}
+
+// Format implements the fmt.Formatter interface.
+// See Set.Format for the supported verbs.
+func (s *ShardedSet[T]) Format(f fmt.State, verb rune) {
+	var zero T
+	s.Snapshot().format(f, verb, "menge.NewShardedSet["+reflect.TypeOf(zero).String()+"]")
+}
+
+// Equals indicates whether s and t are equal.
+func (s *ShardedSet[T]) Equals(t *ShardedSet[T]) bool {
+	return s.Snapshot().Equals(t.Snapshot())
+}
+
+// Union returns the union of s and t, with the same number of shards as s.
+func (s *ShardedSet[T]) Union(t *ShardedSet[T]) *ShardedSet[T] {
+	return s.from(s.Snapshot().Union(t.Snapshot()))
+}
+
+// Intersection returns the intersection of s and t,
+// with the same number of shards as s.
+func (s *ShardedSet[T]) Intersection(t *ShardedSet[T]) *ShardedSet[T] {
+	return s.from(s.Snapshot().Intersection(t.Snapshot()))
+}
+
+// Difference returns the difference of s and t, i.e., s - t,
+// with the same number of shards as s.
+func (s *ShardedSet[T]) Difference(t *ShardedSet[T]) *ShardedSet[T] {
+	return s.from(s.Snapshot().Difference(t.Snapshot()))
+}
+
+// SymmetricDifference returns the symmetric difference of s and t,
+// i.e., the elements that are in either s or t but not in both,
+// with the same number of shards as s.
+func (s *ShardedSet[T]) SymmetricDifference(t *ShardedSet[T]) *ShardedSet[T] {
+	return s.from(s.Snapshot().SymmetricDifference(t.Snapshot()))
+}
+
+// UnionWith adds the elements of t to s, i.e., s = s ⋃ t.
+// The update is atomic.
+func (s *ShardedSet[T]) UnionWith(t *ShardedSet[T]) {
+	u := t.Snapshot()
+	s.lockAll()
+	defer s.unlockAll()
+	for e := range u {
+		s.shard(e).m[e] = struct{}{}
+	}
+}
+
+// IntersectWith removes the elements of s that are not in t, i.e., s = s ⋂ t.
+// The update is atomic.
+func (s *ShardedSet[T]) IntersectWith(t *ShardedSet[T]) {
+	u := t.Snapshot()
+	s.lockAll()
+	defer s.unlockAll()
+	for i := range s.shards {
+		s.shards[i].m.IntersectWith(u)
+	}
+}
+
+// DifferenceWith removes the elements of t from s, i.e., s = s - t.
+// The update is atomic.
+func (s *ShardedSet[T]) DifferenceWith(t *ShardedSet[T]) {
+	u := t.Snapshot()
+	s.lockAll()
+	defer s.unlockAll()
+	for e := range u {
+		delete(s.shard(e).m, e)
+	}
+}
+
+// SymmetricDifferenceWith replaces s with the symmetric difference of s and t,
+// i.e., removes the elements of t that are in s and adds those that are not.
+// The update is atomic.
+func (s *ShardedSet[T]) SymmetricDifferenceWith(t *ShardedSet[T]) {
+	u := t.Snapshot()
+	s.lockAll()
+	defer s.unlockAll()
+	for e := range u {
+		m := s.shard(e).m
+		if _, ok := m[e]; ok {
+			delete(m, e)
+		} else {
+			m[e] = struct{}{}
+		}
+	}
+}
+
+// IsSubsetOf indicates whether s is a subset of t.
+func (s *ShardedSet[T]) IsSubsetOf(t *ShardedSet[T]) bool {
+	return s.Snapshot().IsSubsetOf(t.Snapshot())
+}
+
+// IsProperSubsetOf indicates whether s is a proper subset of t.
+func (s *ShardedSet[T]) IsProperSubsetOf(t *ShardedSet[T]) bool {
+	return s.Snapshot().IsProperSubsetOf(t.Snapshot())
+}
+
+// IsSupersetOf indicates whether s is a superset of t.
+func (s *ShardedSet[T]) IsSupersetOf(t *ShardedSet[T]) bool {
+	return s.Snapshot().IsSupersetOf(t.Snapshot())
+}
+
+// IsProperSupersetOf indicates whether s is a proper superset of t.
+func (s *ShardedSet[T]) IsProperSupersetOf(t *ShardedSet[T]) bool {
+	return s.Snapshot().IsProperSupersetOf(t.Snapshot())
+}
+
+// IsDisjointFrom indicates whether s and t are disjoint.
+func (s *ShardedSet[T]) IsDisjointFrom(t *ShardedSet[T]) bool {
+	return s.Snapshot().IsDisjointFrom(t.Snapshot())
+}
+
+// sizes returns the size of the intersection of s and t and the sizes of s
+// and t, observed at the same time. It locks both sets, in the order of their
+// addresses to avoid deadlocks, and does not allocate.
+func (s *ShardedSet[T]) sizes(t *ShardedSet[T]) (i, m, n int) {
+	if s == t {
+		s.rlockAll()
+		defer s.runlockAll()
+		m = s.size()
+		return m, m, m
+	}
+	first, second := s, t
+	if uintptr(unsafe.Pointer(t)) < uintptr(unsafe.Pointer(s)) {
+		first, second = t, s
+	}
+	first.rlockAll()
+	defer first.runlockAll()
+	second.rlockAll()
+	defer second.runlockAll()
+	m, n = s.size(), t.size()
+	small, large := s, t
+	if n < m {
+		small, large = t, s
+	}
+	for j := range small.shards {
+		for e := range small.shards[j].m {
+			if _, ok := large.shard(e).m[e]; ok {
+				i++
+			}
+		}
+	}
+	return i, m, n
+}
+
+// IntersectionSize returns the size of the intersection of s and t,
+// without computing the intersection.
+func (s *ShardedSet[T]) IntersectionSize(t *ShardedSet[T]) int {
+	i, _, _ := s.sizes(t)
+	return i
+}
+
+// UnionSize returns the size of the union of s and t,
+// without computing the union.
+func (s *ShardedSet[T]) UnionSize(t *ShardedSet[T]) int {
+	i, m, n := s.sizes(t)
+	return m + n - i
+}
+
+// DifferenceSize returns the size of the difference of s and t, i.e., s - t,
+// without computing the difference.
+func (s *ShardedSet[T]) DifferenceSize(t *ShardedSet[T]) int {
+	i, m, _ := s.sizes(t)
+	return m - i
+}
+
+// Jaccard returns the Jaccard index of s and t, i.e., |s ⋂ t| / |s ⋃ t|.
+// The Jaccard index of two empty sets is 1.
+func (s *ShardedSet[T]) Jaccard(t *ShardedSet[T]) float64 {
+	i, m, n := s.sizes(t)
+	return jaccard(i, m, n)
+}
+
+// SorensenDice returns the Sørensen–Dice coefficient of s and t,
+// i.e., 2|s ⋂ t| / (|s| + |t|).
+// The Sørensen–Dice coefficient of two empty sets is 1.
+func (s *ShardedSet[T]) SorensenDice(t *ShardedSet[T]) float64 {
+	i, m, n := s.sizes(t)
+	return sorensenDice(i, m, n)
+}
+
+// OverlapCoefficient returns the overlap coefficient of s and t,
+// i.e., |s ⋂ t| / min(|s|, |t|).
+// The overlap coefficient of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s *ShardedSet[T]) OverlapCoefficient(t *ShardedSet[T]) float64 {
+	i, m, n := s.sizes(t)
+	return overlapCoefficient(i, m, n)
+}
+
+// CosineSimilarity returns the cosine similarity of s and t,
+// i.e., |s ⋂ t| / √(|s||t|).
+// The cosine similarity of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s *ShardedSet[T]) CosineSimilarity(t *ShardedSet[T]) float64 {
+	i, m, n := s.sizes(t)
+	return cosineSimilarity(i, m, n)
+}
+
+// Filter returns a new set containing the elements of a snapshot of s for
+// which pred returns true.
+func (s *ShardedSet[T]) Filter(pred func(elem T) bool) *ShardedSet[T] {
+	return s.from(s.Snapshot().Filter(pred))
+}
+
+// Retain removes the elements of s for which pred returns false.
+// All shards are locked while pred is called, so pred must not access s.
+func (s *ShardedSet[T]) Retain(pred func(elem T) bool) {
+	s.lockAll()
+	defer s.unlockAll()
+	for i := range s.shards {
+		s.shards[i].m.Retain(pred)
+	}
+}
+
+// RemoveIf removes the elements of s for which pred returns true.
+// All shards are locked while pred is called, so pred must not access s.
+func (s *ShardedSet[T]) RemoveIf(pred func(elem T) bool) {
+	s.lockAll()
+	defer s.unlockAll()
+	for i := range s.shards {
+		s.shards[i].m.RemoveIf(pred)
+	}
+}
+
+// Partition returns two new sets containing the elements of a snapshot of s
+// for which pred returns true and false, respectively.
+func (s *ShardedSet[T]) Partition(pred func(elem T) bool) (in, out *ShardedSet[T]) {
+	i, o := s.Snapshot().Partition(pred)
+	return s.from(i), s.from(o)
+}
+
+// Any indicates whether pred returns true for any element of a snapshot of s.
+func (s *ShardedSet[T]) Any(pred func(elem T) bool) bool {
+	return s.Snapshot().Any(pred)
+}
+
+// Every indicates whether pred returns true for all elements of a snapshot of s.
+func (s *ShardedSet[T]) Every(pred func(elem T) bool) bool {
+	return s.Snapshot().Every(pred)
+}
+
+// None indicates whether pred returns false for all elements of a snapshot
+// of s.
+func (s *ShardedSet[T]) None(pred func(elem T) bool) bool {
+	return s.Snapshot().None(pred)
+}
+
+// Count returns the number of elements of a snapshot of s for which pred
+// returns true.
+func (s *ShardedSet[T]) Count(pred func(elem T) bool) int {
+	return s.Snapshot().Count(pred)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// See Set.MarshalJSON for the encoding.
+func (s *ShardedSet[T]) MarshalJSON() ([]byte, error) {
+	return s.Snapshot().MarshalJSON()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It replaces the elements of the set with the decoded ones.
+// The update is atomic: the elements are decoded and partitioned into new
+// shards first, which then replace all shards at once.
+func (s *ShardedSet[T]) UnmarshalJSON(data []byte) error {
+	var t Set[T]
+	if err := json.Unmarshal(data, &t); err != nil || t == nil {
+		return err
+	}
+	s.init()
+	ms := make([]Set[T], s.n)
+	for i := range ms {
+		ms[i] = Set[T]{}
+	}
+	for e := range t {
+		ms[s.hash(e)&uint64(s.n-1)][e] = struct{}{}
+	}
+	s.lockAll()
+	defer s.unlockAll()
+	for i := range s.shards {
+		s.shards[i].m = ms[i]
+	}
+	return nil
+}
+
+// from returns a new ShardedSet with the elements of t
+// and the same number of shards as s.
+func (s *ShardedSet[T]) from(t Set[T]) *ShardedSet[T] {
+	r := NewShardedSetN[T](s.Shards())
+	for e := range t {
+		r.Add(e)
+	}
+	return r
+}
+
+// NewShardedSet returns a new ShardedSet containing zero or more elements.
+// The number of shards is four times GOMAXPROCS, rounded up to a power of two.
+func NewShardedSet[T Integer | ~string](elems ...T) *ShardedSet[T] {
+	return NewShardedSetN(0, elems...)
+}
+
+// NewShardedSetN returns a new ShardedSet with n shards, rounded up to a
+// power of two, containing zero or more elements. If n is not positive,
+// the default number of shards is used; see NewShardedSet.
+func NewShardedSetN[T Integer | ~string](n int, elems ...T) *ShardedSet[T] {
+	s := &ShardedSet[T]{n: n}
+	s.Add(elems...)
+	return s
+}
+
+// shardHash returns a hash function for T.
+func shardHash[T Integer | ~string]() func(T) uint64 {
+	var zero T
+	var f any
+	switch any(zero).(type) {
+	case int:
+		f = func(e int) uint64 { return mix64(uint64(e)) }
+	case int8:
+		f = func(e int8) uint64 { return mix64(uint64(e)) }
+	case int16:
+		f = func(e int16) uint64 { return mix64(uint64(e)) }
+	case int32:
+		f = func(e int32) uint64 { return mix64(uint64(e)) }
+	case int64:
+		f = func(e int64) uint64 { return mix64(uint64(e)) }
+	case uint:
+		f = func(e uint) uint64 { return mix64(uint64(e)) }
+	case uint8:
+		f = func(e uint8) uint64 { return mix64(uint64(e)) }
+	case uint16:
+		f = func(e uint16) uint64 { return mix64(uint64(e)) }
+	case uint32:
+		f = func(e uint32) uint64 { return mix64(uint64(e)) }
+	case uint64:
+		f = mix64
+	case uintptr:
+		f = func(e uintptr) uint64 { return mix64(uint64(e)) }
+	case string:
+		f = stringHash
+	}
+	if f != nil {
+		return f.(func(T) uint64)
+	}
+	// Named types are hashed by kind.
+	switch reflect.TypeOf(zero).Kind() {
+	case reflect.String:
+		return func(e T) uint64 { return stringHash(reflect.ValueOf(e).String()) }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(e T) uint64 { return mix64(reflect.ValueOf(e).Uint()) }
+	}
+	return func(e T) uint64 { return mix64(uint64(reflect.ValueOf(e).Int())) }
+}
+
+// mix64 scrambles the bits of x; it is the finalizer of SplitMix64.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// stringHash returns the 64-bit FNV-1a hash of s.
+func stringHash(s string) uint64 {
+	h := uint64(14695981039346656037)
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= 1099511628211
+	}
+	return h
+}
+
+// Sharded sets of all integer types and strings.
+type (
+	// ShardedIntSet represents a sharded concurrency-safe set of int elements.
+	ShardedIntSet = ShardedSet[int]
+	// ShardedInt16Set represents a sharded concurrency-safe set of int16 elements.
+	ShardedInt16Set = ShardedSet[int16]
+	// ShardedInt32Set represents a sharded concurrency-safe set of int32 elements.
+	ShardedInt32Set = ShardedSet[int32]
+	// ShardedInt64Set represents a sharded concurrency-safe set of int64 elements.
+	ShardedInt64Set = ShardedSet[int64]
+	// ShardedInt8Set represents a sharded concurrency-safe set of int8 elements.
+	ShardedInt8Set = ShardedSet[int8]
+	// ShardedStringSet represents a sharded concurrency-safe set of string elements.
+	ShardedStringSet = ShardedSet[string]
+	// ShardedUIntSet represents a sharded concurrency-safe set of uint elements.
+	ShardedUIntSet = ShardedSet[uint]
+	// ShardedUInt16Set represents a sharded concurrency-safe set of uint16 elements.
+	ShardedUInt16Set = ShardedSet[uint16]
+	// ShardedUInt32Set represents a sharded concurrency-safe set of uint32 elements.
+	ShardedUInt32Set = ShardedSet[uint32]
+	// ShardedUInt64Set represents a sharded concurrency-safe set of uint64 elements.
+	ShardedUInt64Set = ShardedSet[uint64]
+	// ShardedUInt8Set represents a sharded concurrency-safe set of uint8 elements.
+	ShardedUInt8Set = ShardedSet[uint8]
+	// ShardedUIntPtrSet represents a sharded concurrency-safe set of uintptr elements.
+	ShardedUIntPtrSet = ShardedSet[uintptr]
+)
+
+// NewShardedIntSet returns a new ShardedIntSet containing zero or more elements.
+func NewShardedIntSet(elems ...int) *ShardedIntSet {
+	return NewShardedSet(elems...)
+}
+
+// NewShardedInt16Set returns a new ShardedInt16Set containing zero or more elements.
+func NewShardedInt16Set(elems ...int16) *ShardedInt16Set {
+	return NewShardedSet(elems...)
+}
+
+// NewShardedInt32Set returns a new ShardedInt32Set containing zero or more elements.
+func NewShardedInt32Set(elems ...int32) *ShardedInt32Set {
+	return NewShardedSet(elems...)
+}
+
+// NewShardedInt64Set returns a new ShardedInt64Set containing zero or more elements.
+func NewShardedInt64Set(elems ...int64) *ShardedInt64Set {
+	return NewShardedSet(elems...)
+}
+
+// NewShardedInt8Set returns a new ShardedInt8Set containing zero or more elements.
+func NewShardedInt8Set(elems ...int8) *ShardedInt8Set {
+	return NewShardedSet(elems...)
+}
+
+// NewShardedStringSet returns a new ShardedStringSet containing zero or more elements.
+func NewShardedStringSet(elems ...string) *ShardedStringSet {
+	return NewShardedSet(elems...)
+}
+
+// NewShardedUIntSet returns a new ShardedUIntSet containing zero or more elements.
+func NewShardedUIntSet(elems ...uint) *ShardedUIntSet {
+	return NewShardedSet(elems...)
+}
+
+// NewShardedUInt16Set returns a new ShardedUInt16Set containing zero or more elements.
+func NewShardedUInt16Set(elems ...uint16) *ShardedUInt16Set {
+	return NewShardedSet(elems...)
+}
+
+// NewShardedUInt32Set returns a new ShardedUInt32Set containing zero or more elements.
+func NewShardedUInt32Set(elems ...uint32) *ShardedUInt32Set {
+	return NewShardedSet(elems...)
+}
+
+// NewShardedUInt64Set returns a new ShardedUInt64Set containing zero or more elements.
+func NewShardedUInt64Set(elems ...uint64) *ShardedUInt64Set {
+	return NewShardedSet(elems...)
+}
+
+// NewShardedUInt8Set returns a new ShardedUInt8Set containing zero or more elements.
+func NewShardedUInt8Set(elems ...uint8) *ShardedUInt8Set {
+	return NewShardedSet(elems...)
+}
+
+// NewShardedUIntPtrSet returns a new ShardedUIntPtrSet containing zero or more elements.
+func NewShardedUIntPtrSet(elems ...uintptr) *ShardedUIntPtrSet {
+	return NewShardedSet(elems...)
+}
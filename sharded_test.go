@@ -0,0 +1,308 @@
+package menge_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/soroushj/menge"
+)
+
+// TestShardedSet compares the results of ShardedSet with those of Set.
+func TestShardedSet(t *testing.T) {
+	sets := [][]int{{}, {1}, {2}, {1, 2}, {2, 3}, {1, 2, 3}}
+	for _, a := range sets {
+		for _, b := range sets {
+			s, u := menge.NewShardedIntSet(a...), menge.NewShardedSetN(2, b...)
+			ms, mu := menge.NewSet(a...), menge.NewSet(b...)
+			if got, want := s.Equals(u), ms.Equals(mu); got != want {
+				t.Errorf("%v Equals %v got: %v", a, b, got)
+			}
+			if got, want := s.Union(u).Snapshot(), ms.Union(mu); !got.Equals(want) {
+				t.Errorf("%v Union %v got: %v", a, b, got)
+			}
+			if got, want := s.Intersection(u).Snapshot(), ms.Intersection(mu); !got.Equals(want) {
+				t.Errorf("%v Intersection %v got: %v", a, b, got)
+			}
+			if got, want := s.Difference(u).Snapshot(), ms.Difference(mu); !got.Equals(want) {
+				t.Errorf("%v Difference %v got: %v", a, b, got)
+			}
+			if got, want := s.SymmetricDifference(u).Snapshot(), ms.SymmetricDifference(mu); !got.Equals(want) {
+				t.Errorf("%v SymmetricDifference %v got: %v", a, b, got)
+			}
+			for _, op := range []struct {
+				name string
+				f    func(s, u *menge.ShardedIntSet)
+				g    func(s, u menge.Set[int])
+			}{
+				{"UnionWith", (*menge.ShardedIntSet).UnionWith, menge.Set[int].UnionWith},
+				{"IntersectWith", (*menge.ShardedIntSet).IntersectWith, menge.Set[int].IntersectWith},
+				{"DifferenceWith", (*menge.ShardedIntSet).DifferenceWith, menge.Set[int].DifferenceWith},
+				{"SymmetricDifferenceWith", (*menge.ShardedIntSet).SymmetricDifferenceWith, menge.Set[int].SymmetricDifferenceWith},
+			} {
+				got, want := s.Clone(), ms.Clone()
+				op.f(got, u)
+				op.g(want, mu)
+				if !got.Snapshot().Equals(want) {
+					t.Errorf("%v %s %v got: %v", a, op.name, b, got)
+				}
+			}
+			if got, want := s.IsSubsetOf(u), ms.IsSubsetOf(mu); got != want {
+				t.Errorf("%v IsSubsetOf %v got: %v", a, b, got)
+			}
+			if got, want := s.IsProperSubsetOf(u), ms.IsProperSubsetOf(mu); got != want {
+				t.Errorf("%v IsProperSubsetOf %v got: %v", a, b, got)
+			}
+			if got, want := s.IsSupersetOf(u), ms.IsSupersetOf(mu); got != want {
+				t.Errorf("%v IsSupersetOf %v got: %v", a, b, got)
+			}
+			if got, want := s.IsProperSupersetOf(u), ms.IsProperSupersetOf(mu); got != want {
+				t.Errorf("%v IsProperSupersetOf %v got: %v", a, b, got)
+			}
+			if got, want := s.IsDisjointFrom(u), ms.IsDisjointFrom(mu); got != want {
+				t.Errorf("%v IsDisjointFrom %v got: %v", a, b, got)
+			}
+			if got, want := s.IntersectionSize(u), ms.IntersectionSize(mu); got != want {
+				t.Errorf("%v IntersectionSize %v got: %v", a, b, got)
+			}
+			if got, want := s.UnionSize(u), ms.UnionSize(mu); got != want {
+				t.Errorf("%v UnionSize %v got: %v", a, b, got)
+			}
+			if got, want := s.DifferenceSize(u), ms.DifferenceSize(mu); got != want {
+				t.Errorf("%v DifferenceSize %v got: %v", a, b, got)
+			}
+			if got, want := s.Jaccard(u), ms.Jaccard(mu); got != want {
+				t.Errorf("%v Jaccard %v got: %v", a, b, got)
+			}
+			if got, want := s.SorensenDice(u), ms.SorensenDice(mu); got != want {
+				t.Errorf("%v SorensenDice %v got: %v", a, b, got)
+			}
+			if got, want := s.OverlapCoefficient(u), ms.OverlapCoefficient(mu); got != want {
+				t.Errorf("%v OverlapCoefficient %v got: %v", a, b, got)
+			}
+			if got, want := s.CosineSimilarity(u), ms.CosineSimilarity(mu); got != want {
+				t.Errorf("%v CosineSimilarity %v got: %v", a, b, got)
+			}
+		}
+		s, ms := menge.NewShardedIntSet(a...), menge.NewSet(a...)
+		if got, want := s.AsSortedSlice(), ms.AsSortedSlice(); !reflect.DeepEqual(got, want) {
+			t.Errorf("%v AsSortedSlice got: %v", a, got)
+		}
+		if got, want := s.AsSortedSliceDesc(), ms.AsSortedSliceDesc(); !reflect.DeepEqual(got, want) {
+			t.Errorf("%v AsSortedSliceDesc got: %v", a, got)
+		}
+		if got := s.AppendTo([]int{0}); got[0] != 0 || !menge.NewSet(got[1:]...).Equals(ms) || len(got) != len(a)+1 {
+			t.Errorf("%v AppendTo got: %v", a, got)
+		}
+		odd := func(e int) bool { return e%2 == 1 }
+		if got, want := s.Filter(odd).Snapshot(), ms.Filter(odd); !got.Equals(want) {
+			t.Errorf("%v Filter got: %v", a, got)
+		}
+		in, out := s.Partition(odd)
+		if wantIn, wantOut := ms.Partition(odd); !in.Snapshot().Equals(wantIn) || !out.Snapshot().Equals(wantOut) {
+			t.Errorf("%v Partition got: %v %v", a, in, out)
+		}
+		if s.Any(odd) != ms.Any(odd) || s.Every(odd) != ms.Every(odd) || s.None(odd) != ms.None(odd) || s.Count(odd) != ms.Count(odd) {
+			t.Errorf("%v predicates got: %v %v %v %v", a, s.Any(odd), s.Every(odd), s.None(odd), s.Count(odd))
+		}
+		for _, op := range []struct {
+			name string
+			f    func(s *menge.ShardedIntSet, pred func(int) bool)
+			g    func(s menge.Set[int], pred func(int) bool)
+		}{
+			{"Retain", (*menge.ShardedIntSet).Retain, menge.Set[int].Retain},
+			{"RemoveIf", (*menge.ShardedIntSet).RemoveIf, menge.Set[int].RemoveIf},
+		} {
+			got, want := s.Clone(), ms.Clone()
+			op.f(got, odd)
+			op.g(want, odd)
+			if !got.Snapshot().Equals(want) {
+				t.Errorf("%v %s got: %v", a, op.name, got)
+			}
+		}
+		if s.Size() != ms.Size() || s.IsEmpty() != ms.IsEmpty() || s.String() != ms.String() {
+			t.Errorf("%v got: %v", a, s)
+		}
+		if !menge.NewSet(s.AsSlice()...).Equals(ms) || !s.Clone().Snapshot().Equals(ms) {
+			t.Errorf("%v got: %v", a, s)
+		}
+	}
+}
+
+func TestShardedSet_Mutations(t *testing.T) {
+	var s menge.ShardedStringSet
+	if !s.IsEmpty() || s.Has("a") {
+		t.Errorf("zero value got: %v", &s)
+	}
+	s.Add("a", "b")
+	if !s.AddIfAbsent("c") || s.AddIfAbsent("c") {
+		t.Error("AddIfAbsent")
+	}
+	if !s.RemoveIfPresent("a") || s.RemoveIfPresent("a") {
+		t.Error("RemoveIfPresent")
+	}
+	s.Remove("b")
+	if got := s.Snapshot(); !got.Equals(menge.NewSet("c")) {
+		t.Errorf("got: %v", got)
+	}
+	s.Empty()
+	if !s.IsEmpty() {
+		t.Errorf("Empty got: %v", &s)
+	}
+	if n := menge.NewShardedSetN[uint8](5).Shards(); n != 8 {
+		t.Errorf("Shards got: %v", n)
+	}
+	type name string
+	u := menge.NewShardedSetN[name](4, "x", "y", "z")
+	n := 0
+	u.Range(func(e name) bool {
+		n++
+		return n < 2
+	})
+	if n != 2 {
+		t.Errorf("Range got %d calls", n)
+	}
+	if got := fmt.Sprintf("%#v", u); got != `menge.NewShardedSet[menge_test.name]("x", "y", "z")` {
+		t.Errorf("%%#v got: %v", got)
+	}
+	data, err := json.Marshal(u)
+	if err != nil || string(data) != `["x","y","z"]` {
+		t.Errorf("marshal got: %s %v", data, err)
+	}
+	var v menge.ShardedInt64Set
+	if err := json.Unmarshal([]byte("[-1,5]"), &v); err != nil {
+		t.Fatal(err)
+	}
+	if got := v.Snapshot(); !got.Equals(menge.NewSet[int64](-1, 5)) {
+		t.Errorf("unmarshal got: %v", got)
+	}
+}
+
+// TestShardedSet_Race must be run with -race to be meaningful.
+func TestShardedSet_Race(t *testing.T) {
+	const goroutines, n = 16, 1000
+	s := menge.NewShardedSetN[int](8)
+	u := menge.NewShardedSetN[int](4)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	total := 0
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			won := 0
+			for i := 0; i < n; i++ {
+				if s.AddIfAbsent(i) {
+					won++
+				}
+				s.Has(i + 1)
+				u.Add(i % 10)
+				if i%100 == 0 {
+					_ = s.Size()
+					_ = s.Snapshot()
+					_ = s.Jaccard(u)
+					_ = u.Jaccard(s)
+				}
+			}
+			mu.Lock()
+			total += won
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	if total != n || s.Size() != n {
+		t.Errorf("got %d insertions and size %d, want %d", total, s.Size(), n)
+	}
+}
+
+func TestShardedSet_UnmarshalJSONAtomic(t *testing.T) {
+	small, large := []byte("[1,2]"), []byte("[1,2,3,4,5,6,7,8]")
+	s := menge.NewShardedSetN[int](8, 1, 2)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			data := small
+			if i%2 == 0 {
+				data = large
+			}
+			if err := json.Unmarshal(data, s); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+loop:
+	for {
+		select {
+		case <-done:
+			break loop
+		default:
+		}
+		if n := s.Size(); n != 2 && n != 8 {
+			t.Errorf("Size during UnmarshalJSON got: %d", n)
+			break
+		}
+	}
+	// The goroutine may still report an error, so the test waits for it.
+	<-done
+}
+
+// benchmarkConcurrent runs b.N operations split among goroutines,
+// of which 90% are calls to has and 10% are calls to add.
+func benchmarkConcurrent(b *testing.B, goroutines int, has func(int) bool, add func(int)) {
+	const keys = 1 << 16
+	for i := 0; i < keys; i += 2 {
+		add(i)
+	}
+	b.ResetTimer()
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		n := b.N / goroutines
+		if g < b.N%goroutines {
+			n++
+		}
+		wg.Add(1)
+		go func(g, n int) {
+			defer wg.Done()
+			k := g * 7919
+			for i := 0; i < n; i++ {
+				k = (k + 40503) % keys
+				if i%10 == 0 {
+					add(k)
+				} else {
+					has(k)
+				}
+			}
+		}(g, n)
+	}
+	wg.Wait()
+}
+
+func BenchmarkShardedSet(b *testing.B) {
+	for _, g := range []int{1, 4, 16, 64} {
+		b.Run(fmt.Sprintf("RWMutex/goroutines=%d", g), func(b *testing.B) {
+			var mu sync.RWMutex
+			s := menge.NewIntSet()
+			benchmarkConcurrent(b, g, func(e int) bool {
+				mu.RLock()
+				defer mu.RUnlock()
+				return s.Has(e)
+			}, func(e int) {
+				mu.Lock()
+				s.Add(e)
+				mu.Unlock()
+			})
+		})
+		b.Run(fmt.Sprintf("SyncSet/goroutines=%d", g), func(b *testing.B) {
+			s := menge.NewSyncIntSet()
+			benchmarkConcurrent(b, g, s.Has, func(e int) { s.Add(e) })
+		})
+		b.Run(fmt.Sprintf("ShardedSet/goroutines=%d", g), func(b *testing.B) {
+			s := menge.NewShardedIntSet()
+			benchmarkConcurrent(b, g, s.Has, func(e int) { s.Add(e) })
+		})
+	}
+}
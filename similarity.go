@@ -0,0 +1,50 @@
+package menge
+
+import "math"
+
+// The functions below compute similarity coefficients from the size i of the
+// intersection of two sets and the sizes m and n of the sets.
+// Two empty sets are considered identical, i.e., their similarity is 1.
+
+// jaccard returns the Jaccard index, i.e., |s ⋂ t| / |s ⋃ t|.
+func jaccard(i, m, n int) float64 {
+	if m+n == 0 {
+		return 1
+	}
+	return float64(i) / float64(m+n-i)
+}
+
+// sorensenDice returns the Sørensen–Dice coefficient,
+// i.e., 2|s ⋂ t| / (|s| + |t|).
+func sorensenDice(i, m, n int) float64 {
+	if m+n == 0 {
+		return 1
+	}
+	return 2 * float64(i) / float64(m+n)
+}
+
+// overlapCoefficient returns the overlap coefficient,
+// i.e., |s ⋂ t| / min(|s|, |t|).
+func overlapCoefficient(i, m, n int) float64 {
+	switch {
+	case m+n == 0:
+		return 1
+	case m == 0 || n == 0:
+		return 0
+	case m > n:
+		m = n
+	}
+	return float64(i) / float64(m)
+}
+
+// cosineSimilarity returns the cosine similarity of the indicator vectors of
+// the sets, i.e., |s ⋂ t| / √(|s||t|).
+func cosineSimilarity(i, m, n int) float64 {
+	switch {
+	case m+n == 0:
+		return 1
+	case m == 0 || n == 0:
+		return 0
+	}
+	return float64(i) / math.Sqrt(float64(m)*float64(n))
+}
@@ -0,0 +1,29 @@
+// Code generated by mengegen. DO NOT EDIT.
+
+//go:build go1.23
+
+package menge
+
+import "iter"
+
+// All returns an iterator over the elements of the set,
+// with no specific order of the elements.
+func (s StringSet) All() iter.Seq[string] {
+	return Set[string](s).All()
+}
+
+// Sorted returns an iterator over the elements of the set,
+// in their natural order, as used by String.
+// The elements are sorted when the iteration starts.
+func (s StringSet) Sorted() iter.Seq[string] {
+	return Set[string](s).Sorted()
+}
+
+// CollectStringSet returns a new StringSet containing the elements of seq.
+func CollectStringSet(seq iter.Seq[string]) StringSet {
+	s := NewStringSet()
+	for e := range seq {
+		s.Add(e)
+	}
+	return s
+}
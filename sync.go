@@ -0,0 +1,571 @@
+package menge
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"unsafe"
+)
+
+// SyncSet represents a set of elements that is safe for concurrent use by
+// multiple goroutines. Elements that are not equal to themselves, such as NaN,
+// are ignored, as they could never be found or removed.
+// The zero value is an empty set ready to use.
+// A SyncSet must not be copied after first use.
+type SyncSet[T comparable] struct {
+	mu sync.RWMutex
+	m  Set[T]
+}
+
+// Add adds zero or more elements to the set.
+func (s *SyncSet[T]) Add(elems ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.add(elems...)
+}
+
+// add adds elements to the set. The caller must hold the write lock.
+func (s *SyncSet[T]) add(elems ...T) {
+	if s.m == nil {
+		s.m = make(Set[T], len(elems))
+	}
+	for _, e := range elems {
+		if !isNaN(e) {
+			s.m[e] = struct{}{}
+		}
+	}
+}
+
+// AddIfAbsent adds an element to the set if it is absent
+// and indicates whether it was added.
+func (s *SyncSet[T]) AddIfAbsent(elem T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if isNaN(elem) || s.m.Has(elem) {
+		return false
+	}
+	s.add(elem)
+	return true
+}
+
+// Remove removes zero or more elements from the set.
+func (s *SyncSet[T]) Remove(elems ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.Remove(elems...)
+}
+
+// RemoveIfPresent removes an element from the set if it is present
+// and indicates whether it was removed.
+func (s *SyncSet[T]) RemoveIfPresent(elem T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.m.Has(elem) {
+		return false
+	}
+	delete(s.m, elem)
+	return true
+}
+
+// Empty empties the set.
+func (s *SyncSet[T]) Empty() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m = nil
+}
+
+// Replace replaces the elements of the set with zero or more elements.
+func (s *SyncSet[T]) Replace(elems ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m = nil
+	s.add(elems...)
+}
+
+// Swap replaces the elements of the set with the elements of t and returns
+// the previous elements. The set takes ownership of t, which must not be used
+// by the caller afterwards, and the caller takes ownership of the result.
+func (s *SyncSet[T]) Swap(t Set[T]) Set[T] {
+	t = withoutNaN(t)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	old := s.m
+	if old == nil {
+		old = Set[T]{}
+	}
+	s.m = t
+	return old
+}
+
+// Has indicates whether the set has an element.
+func (s *SyncSet[T]) Has(elem T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Has(elem)
+}
+
+// Size returns the size of the set.
+func (s *SyncSet[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.m)
+}
+
+// IsEmpty indicates whether the set is empty.
+func (s *SyncSet[T]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.m) == 0
+}
+
+// Clone returns a clone of the set.
+func (s *SyncSet[T]) Clone() *SyncSet[T] {
+	return &SyncSet[T]{m: s.Snapshot()}
+}
+
+// Snapshot returns a consistent copy of the elements of the set.
+func (s *SyncSet[T]) Snapshot() Set[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Clone()
+}
+
+// AsSlice returns an equivalent slice with no specific order of the elements.
+func (s *SyncSet[T]) AsSlice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.AsSlice()
+}
+
+// AsSortedSlice returns an equivalent slice with the elements in their
+// natural order. See LessFunc for the definition of the order.
+func (s *SyncSet[T]) AsSortedSlice() []T {
+	a := s.AsSlice()
+	sortElems(a)
+	return a
+}
+
+// AsSortedSliceDesc returns an equivalent slice with the elements in the
+// reverse of their natural order.
+func (s *SyncSet[T]) AsSortedSliceDesc() []T {
+	a := s.AsSlice()
+	sort.Sort(sort.Reverse(SortInterface(a)))
+	return a
+}
+
+// AppendTo appends the elements of the set to dst, with no specific order,
+// and returns the extended slice. It allocates only if dst does not have
+// enough capacity.
+func (s *SyncSet[T]) AppendTo(dst []T) []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.AppendTo(dst)
+}
+
+// String returns a string representation of the set,
+// with its elements in their natural order.
+func (s *SyncSet[T]) String() string {
+	return s.Snapshot().String()
+}
+
+// Format implements the fmt.Formatter interface.
+// See Set.Format for the supported verbs.
+func (s *SyncSet[T]) Format(f fmt.State, verb rune) {
+	var zero T
+	s.Snapshot().format(f, verb, "menge.NewSyncSet["+reflect.TypeOf(&zero).Elem().String()+"]")
+}
+
+// Equals indicates whether s and t are equal.
+func (s *SyncSet[T]) Equals(t *SyncSet[T]) bool {
+	if s == t {
+		return true
+	}
+	u := t.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Equals(u)
+}
+
+// Union returns the union of s and t.
+func (s *SyncSet[T]) Union(t *SyncSet[T]) *SyncSet[T] {
+	u := t.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return &SyncSet[T]{m: s.m.Union(u)}
+}
+
+// Intersection returns the intersection of s and t.
+func (s *SyncSet[T]) Intersection(t *SyncSet[T]) *SyncSet[T] {
+	u := t.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return &SyncSet[T]{m: s.m.Intersection(u)}
+}
+
+// Difference returns the difference of s and t, i.e., s - t.
+func (s *SyncSet[T]) Difference(t *SyncSet[T]) *SyncSet[T] {
+	u := t.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return &SyncSet[T]{m: s.m.Difference(u)}
+}
+
+// SymmetricDifference returns the symmetric difference of s and t,
+// i.e., the elements that are in either s or t but not in both.
+func (s *SyncSet[T]) SymmetricDifference(t *SyncSet[T]) *SyncSet[T] {
+	u := t.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return &SyncSet[T]{m: s.m.SymmetricDifference(u)}
+}
+
+// UnionWith adds the elements of t to s, i.e., s = s ⋃ t.
+func (s *SyncSet[T]) UnionWith(t *SyncSet[T]) {
+	u := t.Snapshot()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.m == nil {
+		s.m = u
+		return
+	}
+	s.m.UnionWith(u)
+}
+
+// IntersectWith removes the elements of s that are not in t, i.e., s = s ⋂ t.
+func (s *SyncSet[T]) IntersectWith(t *SyncSet[T]) {
+	u := t.Snapshot()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.IntersectWith(u)
+}
+
+// DifferenceWith removes the elements of t from s, i.e., s = s - t.
+func (s *SyncSet[T]) DifferenceWith(t *SyncSet[T]) {
+	u := t.Snapshot()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.DifferenceWith(u)
+}
+
+// SymmetricDifferenceWith replaces s with the symmetric difference of s and t,
+// i.e., removes the elements of t that are in s and adds those that are not.
+func (s *SyncSet[T]) SymmetricDifferenceWith(t *SyncSet[T]) {
+	u := t.Snapshot()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.m == nil {
+		s.m = u
+		return
+	}
+	s.m.SymmetricDifferenceWith(u)
+}
+
+// IsSubsetOf indicates whether s is a subset of t.
+func (s *SyncSet[T]) IsSubsetOf(t *SyncSet[T]) bool {
+	if s == t {
+		return true
+	}
+	u := t.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.IsSubsetOf(u)
+}
+
+// IsProperSubsetOf indicates whether s is a proper subset of t.
+func (s *SyncSet[T]) IsProperSubsetOf(t *SyncSet[T]) bool {
+	if s == t {
+		return false
+	}
+	u := t.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.IsProperSubsetOf(u)
+}
+
+// IsSupersetOf indicates whether s is a superset of t.
+func (s *SyncSet[T]) IsSupersetOf(t *SyncSet[T]) bool {
+	return t.IsSubsetOf(s)
+}
+
+// IsProperSupersetOf indicates whether s is a proper superset of t.
+func (s *SyncSet[T]) IsProperSupersetOf(t *SyncSet[T]) bool {
+	return t.IsProperSubsetOf(s)
+}
+
+// IsDisjointFrom indicates whether s and t are disjoint.
+func (s *SyncSet[T]) IsDisjointFrom(t *SyncSet[T]) bool {
+	if s == t {
+		return s.IsEmpty()
+	}
+	u := t.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.IsDisjointFrom(u)
+}
+
+// sizes returns the size of the intersection of s and t and the sizes of s
+// and t, observed at the same time. It locks both sets, in the order of their
+// addresses to avoid deadlocks, and does not allocate.
+func (s *SyncSet[T]) sizes(t *SyncSet[T]) (i, m, n int) {
+	if s == t {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		return len(s.m), len(s.m), len(s.m)
+	}
+	first, second := s, t
+	if uintptr(unsafe.Pointer(t)) < uintptr(unsafe.Pointer(s)) {
+		first, second = t, s
+	}
+	first.mu.RLock()
+	defer first.mu.RUnlock()
+	second.mu.RLock()
+	defer second.mu.RUnlock()
+	return s.m.IntersectionSize(t.m), len(s.m), len(t.m)
+}
+
+// IntersectionSize returns the size of the intersection of s and t,
+// without computing the intersection.
+func (s *SyncSet[T]) IntersectionSize(t *SyncSet[T]) int {
+	i, _, _ := s.sizes(t)
+	return i
+}
+
+// UnionSize returns the size of the union of s and t,
+// without computing the union.
+func (s *SyncSet[T]) UnionSize(t *SyncSet[T]) int {
+	i, m, n := s.sizes(t)
+	return m + n - i
+}
+
+// DifferenceSize returns the size of the difference of s and t, i.e., s - t,
+// without computing the difference.
+func (s *SyncSet[T]) DifferenceSize(t *SyncSet[T]) int {
+	i, m, _ := s.sizes(t)
+	return m - i
+}
+
+// Jaccard returns the Jaccard index of s and t, i.e., |s ⋂ t| / |s ⋃ t|.
+// The Jaccard index of two empty sets is 1.
+func (s *SyncSet[T]) Jaccard(t *SyncSet[T]) float64 {
+	i, m, n := s.sizes(t)
+	return jaccard(i, m, n)
+}
+
+// SorensenDice returns the Sørensen–Dice coefficient of s and t,
+// i.e., 2|s ⋂ t| / (|s| + |t|).
+// The Sørensen–Dice coefficient of two empty sets is 1.
+func (s *SyncSet[T]) SorensenDice(t *SyncSet[T]) float64 {
+	i, m, n := s.sizes(t)
+	return sorensenDice(i, m, n)
+}
+
+// OverlapCoefficient returns the overlap coefficient of s and t,
+// i.e., |s ⋂ t| / min(|s|, |t|).
+// The overlap coefficient of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s *SyncSet[T]) OverlapCoefficient(t *SyncSet[T]) float64 {
+	i, m, n := s.sizes(t)
+	return overlapCoefficient(i, m, n)
+}
+
+// CosineSimilarity returns the cosine similarity of s and t,
+// i.e., |s ⋂ t| / √(|s||t|).
+// The cosine similarity of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s *SyncSet[T]) CosineSimilarity(t *SyncSet[T]) float64 {
+	i, m, n := s.sizes(t)
+	return cosineSimilarity(i, m, n)
+}
+
+// Filter returns a new set containing the elements of a snapshot of s for
+// which pred returns true.
+func (s *SyncSet[T]) Filter(pred func(elem T) bool) *SyncSet[T] {
+	return &SyncSet[T]{m: s.Snapshot().Filter(pred)}
+}
+
+// Retain removes the elements of s for which pred returns false.
+// The set is locked while pred is called, so pred must not access s.
+func (s *SyncSet[T]) Retain(pred func(elem T) bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.Retain(pred)
+}
+
+// RemoveIf removes the elements of s for which pred returns true.
+// The set is locked while pred is called, so pred must not access s.
+func (s *SyncSet[T]) RemoveIf(pred func(elem T) bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.RemoveIf(pred)
+}
+
+// Partition returns two new sets containing the elements of a snapshot of s
+// for which pred returns true and false, respectively.
+func (s *SyncSet[T]) Partition(pred func(elem T) bool) (in, out *SyncSet[T]) {
+	i, o := s.Snapshot().Partition(pred)
+	return &SyncSet[T]{m: i}, &SyncSet[T]{m: o}
+}
+
+// Any indicates whether pred returns true for any element of a snapshot of s.
+func (s *SyncSet[T]) Any(pred func(elem T) bool) bool {
+	return s.Snapshot().Any(pred)
+}
+
+// Every indicates whether pred returns true for all elements of a snapshot of s.
+func (s *SyncSet[T]) Every(pred func(elem T) bool) bool {
+	return s.Snapshot().Every(pred)
+}
+
+// None indicates whether pred returns false for all elements of a snapshot
+// of s.
+func (s *SyncSet[T]) None(pred func(elem T) bool) bool {
+	return s.Snapshot().None(pred)
+}
+
+// Count returns the number of elements of a snapshot of s for which pred
+// returns true.
+func (s *SyncSet[T]) Count(pred func(elem T) bool) int {
+	return s.Snapshot().Count(pred)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// See Set.MarshalJSON for the encoding.
+func (s *SyncSet[T]) MarshalJSON() ([]byte, error) {
+	return s.Snapshot().MarshalJSON()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It replaces the elements of the set with the decoded ones.
+func (s *SyncSet[T]) UnmarshalJSON(data []byte) error {
+	var t Set[T]
+	if err := json.Unmarshal(data, &t); err != nil || t == nil {
+		return err
+	}
+	s.Swap(t)
+	return nil
+}
+
+// NewSyncSet returns a new SyncSet containing zero or more elements.
+func NewSyncSet[T comparable](elems ...T) *SyncSet[T] {
+	s := &SyncSet[T]{}
+	s.add(elems...)
+	return s
+}
+
+// Concurrency-safe sets of all basic types.
+type (
+	// SyncComplex128Set represents a concurrency-safe set of complex128 elements.
+	SyncComplex128Set = SyncSet[complex128]
+	// SyncComplex64Set represents a concurrency-safe set of complex64 elements.
+	SyncComplex64Set = SyncSet[complex64]
+	// SyncFloat32Set represents a concurrency-safe set of float32 elements.
+	SyncFloat32Set = SyncSet[float32]
+	// SyncFloat64Set represents a concurrency-safe set of float64 elements.
+	SyncFloat64Set = SyncSet[float64]
+	// SyncIntSet represents a concurrency-safe set of int elements.
+	SyncIntSet = SyncSet[int]
+	// SyncInt16Set represents a concurrency-safe set of int16 elements.
+	SyncInt16Set = SyncSet[int16]
+	// SyncInt32Set represents a concurrency-safe set of int32 elements.
+	SyncInt32Set = SyncSet[int32]
+	// SyncInt64Set represents a concurrency-safe set of int64 elements.
+	SyncInt64Set = SyncSet[int64]
+	// SyncInt8Set represents a concurrency-safe set of int8 elements.
+	SyncInt8Set = SyncSet[int8]
+	// SyncStringSet represents a concurrency-safe set of string elements.
+	SyncStringSet = SyncSet[string]
+	// SyncUIntSet represents a concurrency-safe set of uint elements.
+	SyncUIntSet = SyncSet[uint]
+	// SyncUInt16Set represents a concurrency-safe set of uint16 elements.
+	SyncUInt16Set = SyncSet[uint16]
+	// SyncUInt32Set represents a concurrency-safe set of uint32 elements.
+	SyncUInt32Set = SyncSet[uint32]
+	// SyncUInt64Set represents a concurrency-safe set of uint64 elements.
+	SyncUInt64Set = SyncSet[uint64]
+	// SyncUInt8Set represents a concurrency-safe set of uint8 elements.
+	SyncUInt8Set = SyncSet[uint8]
+	// SyncUIntPtrSet represents a concurrency-safe set of uintptr elements.
+	SyncUIntPtrSet = SyncSet[uintptr]
+)
+
+// NewSyncComplex128Set returns a new SyncComplex128Set containing zero or more elements.
+func NewSyncComplex128Set(elems ...complex128) *SyncComplex128Set {
+	return NewSyncSet(elems...)
+}
+
+// NewSyncComplex64Set returns a new SyncComplex64Set containing zero or more elements.
+func NewSyncComplex64Set(elems ...complex64) *SyncComplex64Set {
+	return NewSyncSet(elems...)
+}
+
+// NewSyncFloat32Set returns a new SyncFloat32Set containing zero or more elements.
+func NewSyncFloat32Set(elems ...float32) *SyncFloat32Set {
+	return NewSyncSet(elems...)
+}
+
+// NewSyncFloat64Set returns a new SyncFloat64Set containing zero or more elements.
+func NewSyncFloat64Set(elems ...float64) *SyncFloat64Set {
+	return NewSyncSet(elems...)
+}
+
+// NewSyncIntSet returns a new SyncIntSet containing zero or more elements.
+func NewSyncIntSet(elems ...int) *SyncIntSet {
+	return NewSyncSet(elems...)
+}
+
+// NewSyncInt16Set returns a new SyncInt16Set containing zero or more elements.
+func NewSyncInt16Set(elems ...int16) *SyncInt16Set {
+	return NewSyncSet(elems...)
+}
+
+// NewSyncInt32Set returns a new SyncInt32Set containing zero or more elements.
+func NewSyncInt32Set(elems ...int32) *SyncInt32Set {
+	return NewSyncSet(elems...)
+}
+
+// NewSyncInt64Set returns a new SyncInt64Set containing zero or more elements.
+func NewSyncInt64Set(elems ...int64) *SyncInt64Set {
+	return NewSyncSet(elems...)
+}
+
+// NewSyncInt8Set returns a new SyncInt8Set containing zero or more elements.
+func NewSyncInt8Set(elems ...int8) *SyncInt8Set {
+	return NewSyncSet(elems...)
+}
+
+// NewSyncStringSet returns a new SyncStringSet containing zero or more elements.
+func NewSyncStringSet(elems ...string) *SyncStringSet {
+	return NewSyncSet(elems...)
+}
+
+// NewSyncUIntSet returns a new SyncUIntSet containing zero or more elements.
+func NewSyncUIntSet(elems ...uint) *SyncUIntSet {
+	return NewSyncSet(elems...)
+}
+
+// NewSyncUInt16Set returns a new SyncUInt16Set containing zero or more elements.
+func NewSyncUInt16Set(elems ...uint16) *SyncUInt16Set {
+	return NewSyncSet(elems...)
+}
+
+// NewSyncUInt32Set returns a new SyncUInt32Set containing zero or more elements.
+func NewSyncUInt32Set(elems ...uint32) *SyncUInt32Set {
+	return NewSyncSet(elems...)
+}
+
+// NewSyncUInt64Set returns a new SyncUInt64Set containing zero or more elements.
+func NewSyncUInt64Set(elems ...uint64) *SyncUInt64Set {
+	return NewSyncSet(elems...)
+}
+
+// NewSyncUInt8Set returns a new SyncUInt8Set containing zero or more elements.
+func NewSyncUInt8Set(elems ...uint8) *SyncUInt8Set {
+	return NewSyncSet(elems...)
+}
+
+// NewSyncUIntPtrSet returns a new SyncUIntPtrSet containing zero or more elements.
+func NewSyncUIntPtrSet(elems ...uintptr) *SyncUIntPtrSet {
+	return NewSyncSet(elems...)
+}
@@ -0,0 +1,271 @@
+package menge_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/soroushj/menge"
+)
+
+// TestSyncSet compares the results of SyncSet with those of Set.
+func TestSyncSet(t *testing.T) {
+	sets := [][]int{{}, {1}, {2}, {1, 2}, {2, 3}, {1, 2, 3}}
+	for _, a := range sets {
+		for _, b := range sets {
+			s, u := menge.NewSyncIntSet(a...), menge.NewSyncIntSet(b...)
+			ms, mu := menge.NewSet(a...), menge.NewSet(b...)
+			if got, want := s.Equals(u), ms.Equals(mu); got != want {
+				t.Errorf("%v Equals %v got: %v", a, b, got)
+			}
+			if got, want := s.Union(u).Snapshot(), ms.Union(mu); !got.Equals(want) {
+				t.Errorf("%v Union %v got: %v", a, b, got)
+			}
+			if got, want := s.Intersection(u).Snapshot(), ms.Intersection(mu); !got.Equals(want) {
+				t.Errorf("%v Intersection %v got: %v", a, b, got)
+			}
+			if got, want := s.Difference(u).Snapshot(), ms.Difference(mu); !got.Equals(want) {
+				t.Errorf("%v Difference %v got: %v", a, b, got)
+			}
+			if got, want := s.SymmetricDifference(u).Snapshot(), ms.SymmetricDifference(mu); !got.Equals(want) {
+				t.Errorf("%v SymmetricDifference %v got: %v", a, b, got)
+			}
+			for _, op := range []struct {
+				name string
+				f    func(s, u *menge.SyncIntSet)
+				g    func(s, u menge.Set[int])
+			}{
+				{"UnionWith", (*menge.SyncIntSet).UnionWith, menge.Set[int].UnionWith},
+				{"IntersectWith", (*menge.SyncIntSet).IntersectWith, menge.Set[int].IntersectWith},
+				{"DifferenceWith", (*menge.SyncIntSet).DifferenceWith, menge.Set[int].DifferenceWith},
+				{"SymmetricDifferenceWith", (*menge.SyncIntSet).SymmetricDifferenceWith, menge.Set[int].SymmetricDifferenceWith},
+			} {
+				got, want := s.Clone(), ms.Clone()
+				op.f(got, u)
+				op.g(want, mu)
+				if !got.Snapshot().Equals(want) {
+					t.Errorf("%v %s %v got: %v", a, op.name, b, got)
+				}
+			}
+			if got, want := s.IsSubsetOf(u), ms.IsSubsetOf(mu); got != want {
+				t.Errorf("%v IsSubsetOf %v got: %v", a, b, got)
+			}
+			if got, want := s.IsProperSubsetOf(u), ms.IsProperSubsetOf(mu); got != want {
+				t.Errorf("%v IsProperSubsetOf %v got: %v", a, b, got)
+			}
+			if got, want := s.IsSupersetOf(u), ms.IsSupersetOf(mu); got != want {
+				t.Errorf("%v IsSupersetOf %v got: %v", a, b, got)
+			}
+			if got, want := s.IsProperSupersetOf(u), ms.IsProperSupersetOf(mu); got != want {
+				t.Errorf("%v IsProperSupersetOf %v got: %v", a, b, got)
+			}
+			if got, want := s.IsDisjointFrom(u), ms.IsDisjointFrom(mu); got != want {
+				t.Errorf("%v IsDisjointFrom %v got: %v", a, b, got)
+			}
+			if got, want := s.IntersectionSize(u), ms.IntersectionSize(mu); got != want {
+				t.Errorf("%v IntersectionSize %v got: %v", a, b, got)
+			}
+			if got, want := s.UnionSize(u), ms.UnionSize(mu); got != want {
+				t.Errorf("%v UnionSize %v got: %v", a, b, got)
+			}
+			if got, want := s.DifferenceSize(u), ms.DifferenceSize(mu); got != want {
+				t.Errorf("%v DifferenceSize %v got: %v", a, b, got)
+			}
+			if got, want := s.Jaccard(u), ms.Jaccard(mu); got != want {
+				t.Errorf("%v Jaccard %v got: %v", a, b, got)
+			}
+			if got, want := s.SorensenDice(u), ms.SorensenDice(mu); got != want {
+				t.Errorf("%v SorensenDice %v got: %v", a, b, got)
+			}
+			if got, want := s.OverlapCoefficient(u), ms.OverlapCoefficient(mu); got != want {
+				t.Errorf("%v OverlapCoefficient %v got: %v", a, b, got)
+			}
+			if got, want := s.CosineSimilarity(u), ms.CosineSimilarity(mu); got != want {
+				t.Errorf("%v CosineSimilarity %v got: %v", a, b, got)
+			}
+		}
+		s, ms := menge.NewSyncIntSet(a...), menge.NewSet(a...)
+		if got, want := s.AsSortedSlice(), ms.AsSortedSlice(); !reflect.DeepEqual(got, want) {
+			t.Errorf("%v AsSortedSlice got: %v", a, got)
+		}
+		if got, want := s.AsSortedSliceDesc(), ms.AsSortedSliceDesc(); !reflect.DeepEqual(got, want) {
+			t.Errorf("%v AsSortedSliceDesc got: %v", a, got)
+		}
+		if got := s.AppendTo([]int{0}); got[0] != 0 || !menge.NewSet(got[1:]...).Equals(ms) || len(got) != len(a)+1 {
+			t.Errorf("%v AppendTo got: %v", a, got)
+		}
+		odd := func(e int) bool { return e%2 == 1 }
+		if got, want := s.Filter(odd).Snapshot(), ms.Filter(odd); !got.Equals(want) {
+			t.Errorf("%v Filter got: %v", a, got)
+		}
+		in, out := s.Partition(odd)
+		if wantIn, wantOut := ms.Partition(odd); !in.Snapshot().Equals(wantIn) || !out.Snapshot().Equals(wantOut) {
+			t.Errorf("%v Partition got: %v %v", a, in, out)
+		}
+		if s.Any(odd) != ms.Any(odd) || s.Every(odd) != ms.Every(odd) || s.None(odd) != ms.None(odd) || s.Count(odd) != ms.Count(odd) {
+			t.Errorf("%v predicates got: %v %v %v %v", a, s.Any(odd), s.Every(odd), s.None(odd), s.Count(odd))
+		}
+		for _, op := range []struct {
+			name string
+			f    func(s *menge.SyncIntSet, pred func(int) bool)
+			g    func(s menge.Set[int], pred func(int) bool)
+		}{
+			{"Retain", (*menge.SyncIntSet).Retain, menge.Set[int].Retain},
+			{"RemoveIf", (*menge.SyncIntSet).RemoveIf, menge.Set[int].RemoveIf},
+		} {
+			got, want := s.Clone(), ms.Clone()
+			op.f(got, odd)
+			op.g(want, odd)
+			if !got.Snapshot().Equals(want) {
+				t.Errorf("%v %s got: %v", a, op.name, got)
+			}
+		}
+		if s.Size() != ms.Size() || s.IsEmpty() != ms.IsEmpty() || s.String() != ms.String() {
+			t.Errorf("%v got: %v", a, s)
+		}
+		if !menge.NewSet(s.AsSlice()...).Equals(ms) || !s.Clone().Snapshot().Equals(ms) {
+			t.Errorf("%v got: %v", a, s)
+		}
+		if !s.Equals(s) || !s.IsSubsetOf(s) || s.IsProperSubsetOf(s) || s.IsDisjointFrom(s) != ms.IsEmpty() ||
+			s.IntersectionSize(s) != ms.Size() || s.Jaccard(s) != 1 {
+			t.Errorf("%v compared with itself", a)
+		}
+	}
+}
+
+func TestSyncSet_Mutations(t *testing.T) {
+	var s menge.SyncStringSet
+	if !s.IsEmpty() || s.Has("a") {
+		t.Errorf("zero value got: %v", &s)
+	}
+	s.Add("a", "b")
+	if !s.AddIfAbsent("c") || s.AddIfAbsent("c") {
+		t.Error("AddIfAbsent")
+	}
+	if !s.RemoveIfPresent("a") || s.RemoveIfPresent("a") {
+		t.Error("RemoveIfPresent")
+	}
+	s.Remove("b")
+	if got := s.Snapshot(); !got.Equals(menge.NewSet("c")) {
+		t.Errorf("got: %v", got)
+	}
+	old := s.Swap(menge.NewSet("x", "y"))
+	if !old.Equals(menge.NewSet("c")) || s.Size() != 2 {
+		t.Errorf("Swap got: %v %v", old, &s)
+	}
+	s.Replace("z")
+	if got := s.Snapshot(); !got.Equals(menge.NewSet("z")) {
+		t.Errorf("Replace got: %v", got)
+	}
+	s.Empty()
+	if !s.IsEmpty() {
+		t.Errorf("Empty got: %v", &s)
+	}
+	old = s.Swap(nil)
+	if old == nil || !old.IsEmpty() {
+		t.Errorf("Swap got: %v", old)
+	}
+	s.Add("a")
+	if !s.Has("a") {
+		t.Errorf("Add after Swap(nil) got: %v", &s)
+	}
+}
+
+func TestSyncSet_NaN(t *testing.T) {
+	nan := math.NaN()
+	s := menge.NewSyncFloat64Set(1, nan)
+	s.Add(nan)
+	if s.AddIfAbsent(nan) || s.Size() != 1 {
+		t.Errorf("got: %v", s)
+	}
+	s.Swap(menge.NewSet(2, nan))
+	if got := s.Snapshot(); !got.Equals(menge.NewSet[float64](2)) {
+		t.Errorf("Swap got: %v", got)
+	}
+	c := menge.NewSyncComplex128Set(complex(nan, 0))
+	if !c.IsEmpty() {
+		t.Errorf("got: %v", c)
+	}
+}
+
+func TestSyncSet_Encoding(t *testing.T) {
+	s := menge.NewSyncIntSet(2, 1)
+	if got := fmt.Sprintf("%#v", s); got != "menge.NewSyncSet[int](1, 2)" {
+		t.Errorf("%%#v got: %v", got)
+	}
+	data, err := json.Marshal(s)
+	if err != nil || string(data) != "[1,2]" {
+		t.Errorf("marshal got: %s %v", data, err)
+	}
+	var u menge.SyncIntSet
+	if err := json.Unmarshal([]byte("[3,4]"), &u); err != nil {
+		t.Fatal(err)
+	}
+	if !u.Equals(menge.NewSyncIntSet(3, 4)) {
+		t.Errorf("unmarshal got: %v", &u)
+	}
+}
+
+// TestSyncSet_Race must be run with -race to be meaningful.
+func TestSyncSet_Race(t *testing.T) {
+	const goroutines, n = 16, 1000
+	s := menge.NewSyncIntSet()
+	u := menge.NewSyncIntSet()
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	winners := make(map[int]int)
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			won := 0
+			for i := 0; i < n; i++ {
+				if s.AddIfAbsent(i) {
+					won++
+				}
+				u.Add(i + g)
+				s.Has(i)
+				if i%10 == 0 {
+					_ = s.Union(u)
+					_ = u.Intersection(s)
+					_ = s.IsSubsetOf(u)
+					_ = u.IsSubsetOf(s)
+					_ = s.Jaccard(u)
+					_ = u.Jaccard(s)
+				}
+				if i%100 == 0 {
+					u.Swap(menge.NewSet(i))
+					_ = s.String()
+					_ = s.Snapshot()
+				}
+			}
+			mu.Lock()
+			winners[g] = won
+			mu.Unlock()
+		}(g)
+	}
+	wg.Wait()
+	total := 0
+	for _, won := range winners {
+		total += won
+	}
+	if total != n || s.Size() != n {
+		t.Errorf("got %d insertions and size %d, want %d", total, s.Size(), n)
+	}
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < n; i++ {
+				s.RemoveIfPresent(i)
+			}
+		}()
+	}
+	wg.Wait()
+	if !s.IsEmpty() {
+		t.Errorf("got: %v", s)
+	}
+}
@@ -0,0 +1,29 @@
+package menge
+
+// adder is a set type of elements of type T with an Add method, such as Set
+// or any of the concrete set types of this package.
+type adder[T comparable] interface {
+	~map[T]struct{}
+	Add(elems ...T)
+}
+
+// Map returns a set of type R containing the results of applying f to the
+// elements of s, e.g., Map[StringSet](s, strconv.Itoa) for an IntSet named s.
+// The results are added to the new set with its Add method, so NaN results
+// are ignored by Float32Set and Float64Set, as they are by Add.
+// The size of the result may be less than that of s if f is not injective.
+func Map[R adder[U], S ~map[T]struct{}, T, U comparable](s S, f func(elem T) U) R {
+	r := make(R, len(s))
+	for e := range s {
+		r.Add(f(e))
+	}
+	return r
+}
+
+// Convert returns a set of type R containing the elements of s converted to
+// the element type of R, e.g., Convert[Int64Set](s) for an Int32Set named s.
+// The elements are converted as by a Go conversion, so a narrowing
+// conversion may truncate or round elements and merge them.
+func Convert[R adder[U], S ~map[T]struct{}, T, U Integer | Float](s S) R {
+	return Map[R](s, func(e T) U { return U(e) })
+}
@@ -0,0 +1,54 @@
+package menge_test
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/soroushj/menge"
+)
+
+func TestMap(t *testing.T) {
+	cases := []struct {
+		arg  menge.IntSet
+		want menge.StringSet
+	}{
+		{menge.NewIntSet(), menge.NewStringSet()},
+		{menge.NewIntSet(1), menge.NewStringSet("1")},
+		{menge.NewIntSet(-1, 0, 10), menge.NewStringSet("-1", "0", "10")},
+	}
+	for _, c := range cases {
+		got := menge.Map[menge.StringSet](c.arg, strconv.Itoa)
+		if !got.Equals(c.want) {
+			t.Errorf("case: %v got: %v", c, got)
+		}
+	}
+	abs := func(e int) int {
+		if e < 0 {
+			return -e
+		}
+		return e
+	}
+	if got := menge.Map[menge.Set[int]](menge.NewSet(-2, -1, 1, 2), abs); !got.Equals(menge.NewSet(1, 2)) {
+		t.Errorf("non-injective got: %v", got)
+	}
+	sqrt := func(e int) float64 { return math.Sqrt(float64(e)) }
+	if got := menge.Map[menge.Float64Set](menge.NewIntSet(-1, 4), sqrt); !got.Equals(menge.NewFloat64Set(2)) {
+		t.Errorf("NaN got: %v", got)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	wide := menge.Convert[menge.Int64Set](menge.NewInt32Set(math.MinInt32, 0, math.MaxInt32))
+	if !wide.Equals(menge.NewInt64Set(math.MinInt32, 0, math.MaxInt32)) {
+		t.Errorf("Int32Set to Int64Set got: %v", wide)
+	}
+	float := menge.Convert[menge.Float64Set](menge.NewUInt8Set(0, 255))
+	if !float.Equals(menge.NewFloat64Set(0, 255)) {
+		t.Errorf("UInt8Set to Float64Set got: %v", float)
+	}
+	narrow := menge.Convert[menge.Int8Set](menge.NewIntSet(1, 257))
+	if !narrow.Equals(menge.NewInt8Set(1)) {
+		t.Errorf("IntSet to Int8Set got: %v", narrow)
+	}
+}
@@ -0,0 +1,413 @@
+package menge
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// TrieStringSet represents a set of string elements as a radix tree, i.e., a
+// compressed trie, which shares the storage of the common prefixes of its
+// elements. Besides the methods of StringSet, it finds the elements that
+// start with a prefix and the longest element that is a prefix of a string in
+// time proportional to the length of the prefix, rather than to the size of
+// the set. Its elements are in ascending order, i.e., in the order of their
+// bytes.
+// The zero value is an empty set ready to use.
+type TrieStringSet struct {
+	root radix
+	size int
+}
+
+// Add adds zero or more elements to the set.
+func (s *TrieStringSet) Add(elems ...string) {
+	for _, e := range elems {
+		if s.root.insert(e) {
+			s.size++
+		}
+	}
+}
+
+// Remove removes zero or more elements from the set.
+func (s *TrieStringSet) Remove(elems ...string) {
+	for _, e := range elems {
+		if s.root.remove(e) {
+			s.size--
+		}
+	}
+}
+
+// Empty empties the set.
+func (s *TrieStringSet) Empty() {
+	*s = TrieStringSet{}
+}
+
+// Has indicates whether the set has an element.
+func (s *TrieStringSet) Has(elem string) bool {
+	return s.root.has(elem)
+}
+
+// Size returns the size of the set.
+func (s *TrieStringSet) Size() int {
+	return s.size
+}
+
+// IsEmpty indicates whether the set is empty.
+func (s *TrieStringSet) IsEmpty() bool {
+	return s.size == 0
+}
+
+// Clone returns a clone of the set.
+func (s *TrieStringSet) Clone() *TrieStringSet {
+	return &TrieStringSet{*s.root.clone(), s.size}
+}
+
+// HasPrefix indicates whether the set has an element that starts with prefix.
+func (s *TrieStringSet) HasPrefix(prefix string) bool {
+	n, _ := s.root.seek(prefix)
+	return n != nil
+}
+
+// AscendPrefix calls f for each element of the set that starts with prefix,
+// in ascending order, until f returns false.
+func (s *TrieStringSet) AscendPrefix(prefix string, f func(elem string) bool) {
+	if n, base := s.root.seek(prefix); n != nil {
+		n.ascend([]byte(base), f)
+	}
+}
+
+// LongestPrefixOf returns the longest element of the set that is a prefix of
+// str, e.g., the most specific route of a path, and whether there is one.
+func (s *TrieStringSet) LongestPrefixOf(str string) (string, bool) {
+	l := s.root.longestPrefixOf(str)
+	if l < 0 {
+		return "", false
+	}
+	return str[:l], true
+}
+
+// HasSuffix indicates whether the set has an element that ends with suffix.
+// Unlike HasPrefix, it visits every element of the set.
+func (s *TrieStringSet) HasSuffix(suffix string) bool {
+	return s.Any(func(e string) bool { return strings.HasSuffix(e, suffix) })
+}
+
+// AscendSuffix calls f for each element of the set that ends with suffix,
+// in ascending order, until f returns false.
+// Unlike AscendPrefix, it visits every element of the set.
+func (s *TrieStringSet) AscendSuffix(suffix string, f func(elem string) bool) {
+	s.Ascend(func(e string) bool {
+		return !strings.HasSuffix(e, suffix) || f(e)
+	})
+}
+
+// Ascend calls f for each element of the set in ascending order,
+// until f returns false.
+func (s *TrieStringSet) Ascend(f func(elem string) bool) {
+	s.root.ascend(nil, f)
+}
+
+// Descend calls f for each element of the set in descending order,
+// until f returns false.
+func (s *TrieStringSet) Descend(f func(elem string) bool) {
+	s.root.descend(nil, f)
+}
+
+// AsSlice returns an equivalent slice with the elements in ascending order.
+func (s *TrieStringSet) AsSlice() []string {
+	return s.AppendTo(make([]string, 0, s.size))
+}
+
+// AsSortedSlice returns an equivalent slice with the elements in ascending
+// order. It is the same as AsSlice.
+func (s *TrieStringSet) AsSortedSlice() []string {
+	return s.AsSlice()
+}
+
+// AsSortedSliceDesc returns an equivalent slice with the elements in
+// descending order.
+func (s *TrieStringSet) AsSortedSliceDesc() []string {
+	a := make([]string, 0, s.size)
+	s.Descend(func(e string) bool {
+		a = append(a, e)
+		return true
+	})
+	return a
+}
+
+// AppendTo appends the elements of the set to dst in ascending order and
+// returns the extended slice. It allocates only if dst does not have enough
+// capacity.
+func (s *TrieStringSet) AppendTo(dst []string) []string {
+	s.Ascend(func(e string) bool {
+		dst = append(dst, e)
+		return true
+	})
+	return dst
+}
+
+// ToStringSet returns an equivalent StringSet.
+func (s *TrieStringSet) ToStringSet() StringSet {
+	r := make(StringSet, s.size)
+	s.Ascend(func(e string) bool {
+		r[e] = struct{}{}
+		return true
+	})
+	return r
+}
+
+// String returns a string representation of the set,
+// with its elements in ascending order.
+func (s *TrieStringSet) String() string {
+	b := &strings.Builder{}
+	writeElems(b, s.AsSlice(), "%v", " ")
+	return b.String()
+}
+
+// Format implements the fmt.Formatter interface.
+// See Set.Format for the supported verbs.
+func (s *TrieStringSet) Format(f fmt.State, verb rune) {
+	formatElems(f, verb, s.AsSlice(), "menge.NewTrieStringSet")
+}
+
+// Equals indicates whether s and t are equal.
+func (s *TrieStringSet) Equals(t *TrieStringSet) bool {
+	return s.size == t.size && s.IsSubsetOf(t)
+}
+
+// Union returns the union of s and t.
+func (s *TrieStringSet) Union(t *TrieStringSet) *TrieStringSet {
+	if s.size < t.size {
+		s, t = t, s
+	}
+	r := s.Clone()
+	r.UnionWith(t)
+	return r
+}
+
+// Intersection returns the intersection of s and t.
+func (s *TrieStringSet) Intersection(t *TrieStringSet) *TrieStringSet {
+	if s.size > t.size {
+		s, t = t, s
+	}
+	return s.Filter(t.Has)
+}
+
+// Difference returns the difference of s and t, i.e., s - t.
+func (s *TrieStringSet) Difference(t *TrieStringSet) *TrieStringSet {
+	return s.Filter(func(e string) bool { return !t.Has(e) })
+}
+
+// SymmetricDifference returns the symmetric difference of s and t,
+// i.e., (s - t) ⋃ (t - s).
+func (s *TrieStringSet) SymmetricDifference(t *TrieStringSet) *TrieStringSet {
+	r := s.Difference(t)
+	t.Ascend(func(e string) bool {
+		if !s.Has(e) {
+			r.Add(e)
+		}
+		return true
+	})
+	return r
+}
+
+// UnionWith adds the elements of t to s, i.e., s = s ⋃ t.
+func (s *TrieStringSet) UnionWith(t *TrieStringSet) {
+	s.Add(t.AsSlice()...)
+}
+
+// IntersectWith removes the elements of s that are not in t, i.e., s = s ⋂ t.
+func (s *TrieStringSet) IntersectWith(t *TrieStringSet) {
+	*s = *s.Intersection(t)
+}
+
+// DifferenceWith removes the elements of t from s, i.e., s = s - t.
+func (s *TrieStringSet) DifferenceWith(t *TrieStringSet) {
+	s.Remove(t.AsSlice()...)
+}
+
+// SymmetricDifferenceWith replaces s with the symmetric difference of s and t,
+// i.e., s = (s - t) ⋃ (t - s).
+func (s *TrieStringSet) SymmetricDifferenceWith(t *TrieStringSet) {
+	*s = *s.SymmetricDifference(t)
+}
+
+// IsSubsetOf indicates whether s is a subset of t.
+func (s *TrieStringSet) IsSubsetOf(t *TrieStringSet) bool {
+	return s.size <= t.size && s.Every(t.Has)
+}
+
+// IsProperSubsetOf indicates whether s is a proper subset of t.
+func (s *TrieStringSet) IsProperSubsetOf(t *TrieStringSet) bool {
+	return s.size < t.size && s.IsSubsetOf(t)
+}
+
+// IsSupersetOf indicates whether s is a superset of t.
+func (s *TrieStringSet) IsSupersetOf(t *TrieStringSet) bool {
+	return t.IsSubsetOf(s)
+}
+
+// IsProperSupersetOf indicates whether s is a proper superset of t.
+func (s *TrieStringSet) IsProperSupersetOf(t *TrieStringSet) bool {
+	return t.IsProperSubsetOf(s)
+}
+
+// IsDisjointFrom indicates whether s and t are disjoint.
+func (s *TrieStringSet) IsDisjointFrom(t *TrieStringSet) bool {
+	if s.size > t.size {
+		s, t = t, s
+	}
+	return s.None(t.Has)
+}
+
+// IntersectionSize returns the size of the intersection of s and t,
+// without computing the intersection.
+func (s *TrieStringSet) IntersectionSize(t *TrieStringSet) int {
+	if s.size > t.size {
+		s, t = t, s
+	}
+	return s.Count(t.Has)
+}
+
+// UnionSize returns the size of the union of s and t,
+// without computing the union.
+func (s *TrieStringSet) UnionSize(t *TrieStringSet) int {
+	return s.size + t.size - s.IntersectionSize(t)
+}
+
+// DifferenceSize returns the size of the difference of s and t, i.e., s - t,
+// without computing the difference.
+func (s *TrieStringSet) DifferenceSize(t *TrieStringSet) int {
+	return s.size - s.IntersectionSize(t)
+}
+
+// Jaccard returns the Jaccard index of s and t, i.e., |s ⋂ t| / |s ⋃ t|.
+// The Jaccard index of two empty sets is 1.
+func (s *TrieStringSet) Jaccard(t *TrieStringSet) float64 {
+	return jaccard(s.IntersectionSize(t), s.size, t.size)
+}
+
+// SorensenDice returns the Sørensen–Dice coefficient of s and t,
+// i.e., 2|s ⋂ t| / (|s| + |t|).
+// The Sørensen–Dice coefficient of two empty sets is 1.
+func (s *TrieStringSet) SorensenDice(t *TrieStringSet) float64 {
+	return sorensenDice(s.IntersectionSize(t), s.size, t.size)
+}
+
+// OverlapCoefficient returns the overlap coefficient of s and t,
+// i.e., |s ⋂ t| / min(|s|, |t|).
+// The overlap coefficient of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s *TrieStringSet) OverlapCoefficient(t *TrieStringSet) float64 {
+	return overlapCoefficient(s.IntersectionSize(t), s.size, t.size)
+}
+
+// CosineSimilarity returns the cosine similarity of s and t,
+// i.e., |s ⋂ t| / √(|s||t|).
+// The cosine similarity of two empty sets is 1,
+// and that of an empty and a non-empty set is 0.
+func (s *TrieStringSet) CosineSimilarity(t *TrieStringSet) float64 {
+	return cosineSimilarity(s.IntersectionSize(t), s.size, t.size)
+}
+
+// Filter returns a new set containing the elements of s for which pred
+// returns true.
+func (s *TrieStringSet) Filter(pred func(elem string) bool) *TrieStringSet {
+	r := &TrieStringSet{}
+	s.Ascend(func(e string) bool {
+		if pred(e) {
+			r.Add(e)
+		}
+		return true
+	})
+	return r
+}
+
+// Retain removes the elements of s for which pred returns false.
+func (s *TrieStringSet) Retain(pred func(elem string) bool) {
+	*s = *s.Filter(pred)
+}
+
+// RemoveIf removes the elements of s for which pred returns true.
+func (s *TrieStringSet) RemoveIf(pred func(elem string) bool) {
+	*s = *s.Filter(func(e string) bool { return !pred(e) })
+}
+
+// Partition returns two new sets containing the elements of s for which pred
+// returns true and false, respectively.
+func (s *TrieStringSet) Partition(pred func(elem string) bool) (in, out *TrieStringSet) {
+	in, out = &TrieStringSet{}, &TrieStringSet{}
+	s.Ascend(func(e string) bool {
+		if pred(e) {
+			in.Add(e)
+		} else {
+			out.Add(e)
+		}
+		return true
+	})
+	return in, out
+}
+
+// Any indicates whether pred returns true for any element of s.
+// It returns false for an empty set.
+func (s *TrieStringSet) Any(pred func(elem string) bool) bool {
+	return !s.None(pred)
+}
+
+// Every indicates whether pred returns true for all elements of s.
+// It returns true for an empty set.
+func (s *TrieStringSet) Every(pred func(elem string) bool) bool {
+	return s.root.ascend(nil, pred)
+}
+
+// None indicates whether pred returns false for all elements of s.
+// It returns true for an empty set.
+func (s *TrieStringSet) None(pred func(elem string) bool) bool {
+	return s.root.ascend(nil, func(e string) bool { return !pred(e) })
+}
+
+// Count returns the number of elements of s for which pred returns true.
+func (s *TrieStringSet) Count(pred func(elem string) bool) int {
+	n := 0
+	s.Ascend(func(e string) bool {
+		if pred(e) {
+			n++
+		}
+		return true
+	})
+	return n
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// See Set.MarshalJSON for the encoding.
+func (s *TrieStringSet) MarshalJSON() ([]byte, error) {
+	return marshalElems(s.AsSlice())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It replaces the elements of the set with the decoded ones.
+func (s *TrieStringSet) UnmarshalJSON(data []byte) error {
+	var t Set[string]
+	if err := json.Unmarshal(data, &t); err != nil || t == nil {
+		return err
+	}
+	*s = *FromStringSet(StringSet(t))
+	return nil
+}
+
+// FromStringSet returns a new TrieStringSet containing the elements of s.
+func FromStringSet(s StringSet) *TrieStringSet {
+	r := &TrieStringSet{}
+	for e := range s {
+		r.Add(e)
+	}
+	return r
+}
+
+// NewTrieStringSet returns a new TrieStringSet containing zero or more
+// elements.
+func NewTrieStringSet(elems ...string) *TrieStringSet {
+	s := &TrieStringSet{}
+	s.Add(elems...)
+	return s
+}
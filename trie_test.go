@@ -0,0 +1,247 @@
+package menge_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/soroushj/menge"
+)
+
+// TestTrieStringSet_Random compares TrieStringSet with Set after random
+// additions and removals of strings with many common prefixes.
+func TestTrieStringSet_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	word := func() string {
+		b := make([]byte, r.Intn(6))
+		for i := range b {
+			b[i] = "ab/"[r.Intn(3)]
+		}
+		return string(b)
+	}
+	s, ms := &menge.TrieStringSet{}, menge.Set[string]{}
+	for i := 0; i < 3000; i++ {
+		e := word()
+		if r.Intn(3) == 0 {
+			s.Remove(e)
+			ms.Remove(e)
+		} else {
+			s.Add(e)
+			ms.Add(e)
+		}
+		if s.Size() != ms.Size() || s.Has(e) != ms.Has(e) {
+			t.Fatalf("step %d %q got: %v, want: %v", i, e, s, ms)
+		}
+		p := word()
+		var want []string
+		for _, e := range ms.AsSortedSlice() {
+			if strings.HasPrefix(e, p) {
+				want = append(want, e)
+			}
+		}
+		var got []string
+		s.AscendPrefix(p, func(e string) bool {
+			got = append(got, e)
+			return true
+		})
+		if !reflect.DeepEqual(got, want) || s.HasPrefix(p) != (len(want) != 0) {
+			t.Fatalf("step %d AscendPrefix %q got: %q, want: %q", i, p, got, want)
+		}
+		l, ok := s.LongestPrefixOf(p)
+		for j := len(p); j >= -1; j-- {
+			if j < 0 {
+				if ok {
+					t.Fatalf("step %d LongestPrefixOf %q got: %q", i, p, l)
+				}
+			} else if ms.Has(p[:j]) {
+				if !ok || l != p[:j] {
+					t.Fatalf("step %d LongestPrefixOf %q got: %q %v, want: %q", i, p, l, ok, p[:j])
+				}
+				break
+			}
+		}
+	}
+	if !reflect.DeepEqual(s.AsSlice(), ms.AsSortedSlice()) || !reflect.DeepEqual(s.AsSortedSliceDesc(), ms.AsSortedSliceDesc()) {
+		t.Errorf("got: %v, want: %v", s, ms)
+	}
+	s.Remove(s.AsSlice()...)
+	if !s.IsEmpty() || s.HasPrefix("") {
+		t.Errorf("after removing all elements got: %v", s)
+	}
+}
+
+// TestTrieStringSet compares the results of TrieStringSet with those of Set.
+func TestTrieStringSet(t *testing.T) {
+	sets := [][]string{{}, {""}, {"a"}, {"a", "ab", "abc"}, {"ab", "b", "ba", "abc"}, {"", "x", "api/", "api/v1", "api/v2"}}
+	for _, a := range sets {
+		for _, b := range sets {
+			s, u := menge.NewTrieStringSet(a...), menge.NewTrieStringSet(b...)
+			ms, mu := menge.NewSet(a...), menge.NewSet(b...)
+			if got, want := s.Equals(u), ms.Equals(mu); got != want {
+				t.Errorf("%q Equals %q got: %v", a, b, got)
+			}
+			for _, op := range []struct {
+				name string
+				f    func(s, u *menge.TrieStringSet) *menge.TrieStringSet
+				g    func(s, u menge.Set[string]) menge.Set[string]
+			}{
+				{"Union", (*menge.TrieStringSet).Union, menge.Set[string].Union},
+				{"Intersection", (*menge.TrieStringSet).Intersection, menge.Set[string].Intersection},
+				{"Difference", (*menge.TrieStringSet).Difference, menge.Set[string].Difference},
+				{"SymmetricDifference", (*menge.TrieStringSet).SymmetricDifference, menge.Set[string].SymmetricDifference},
+			} {
+				got, want := op.f(s, u), op.g(ms, mu)
+				if !reflect.DeepEqual(got.AsSlice(), want.AsSortedSlice()) || got.Size() != want.Size() {
+					t.Errorf("%q %s %q got: %v", a, op.name, b, got)
+				}
+			}
+			for _, op := range []struct {
+				name string
+				f    func(s, u *menge.TrieStringSet)
+				g    func(s, u menge.Set[string]) menge.Set[string]
+			}{
+				{"UnionWith", (*menge.TrieStringSet).UnionWith, menge.Set[string].Union},
+				{"IntersectWith", (*menge.TrieStringSet).IntersectWith, menge.Set[string].Intersection},
+				{"DifferenceWith", (*menge.TrieStringSet).DifferenceWith, menge.Set[string].Difference},
+				{"SymmetricDifferenceWith", (*menge.TrieStringSet).SymmetricDifferenceWith, menge.Set[string].SymmetricDifference},
+			} {
+				got := s.Clone()
+				op.f(got, u)
+				if want := op.g(ms, mu); !reflect.DeepEqual(got.AsSlice(), want.AsSortedSlice()) || got.Size() != want.Size() {
+					t.Errorf("%q %s %q got: %v", a, op.name, b, got)
+				}
+			}
+			for _, op := range []struct {
+				name string
+				f    func(s, u *menge.TrieStringSet) bool
+				g    func(s, u menge.Set[string]) bool
+			}{
+				{"IsSubsetOf", (*menge.TrieStringSet).IsSubsetOf, menge.Set[string].IsSubsetOf},
+				{"IsProperSubsetOf", (*menge.TrieStringSet).IsProperSubsetOf, menge.Set[string].IsProperSubsetOf},
+				{"IsSupersetOf", (*menge.TrieStringSet).IsSupersetOf, menge.Set[string].IsSupersetOf},
+				{"IsProperSupersetOf", (*menge.TrieStringSet).IsProperSupersetOf, menge.Set[string].IsProperSupersetOf},
+				{"IsDisjointFrom", (*menge.TrieStringSet).IsDisjointFrom, menge.Set[string].IsDisjointFrom},
+			} {
+				if got, want := op.f(s, u), op.g(ms, mu); got != want {
+					t.Errorf("%q %s %q got: %v", a, op.name, b, got)
+				}
+			}
+			if got, want := s.UnionSize(u)+s.DifferenceSize(u), ms.UnionSize(mu)+ms.DifferenceSize(mu); got != want {
+				t.Errorf("%q sizes %q got: %v", a, b, got)
+			}
+			if got, want := s.Jaccard(u)+s.SorensenDice(u)+s.OverlapCoefficient(u)+s.CosineSimilarity(u),
+				ms.Jaccard(mu)+ms.SorensenDice(mu)+ms.OverlapCoefficient(mu)+ms.CosineSimilarity(mu); got != want {
+				t.Errorf("%q similarity %q got: %v", a, b, got)
+			}
+			if !reflect.DeepEqual(s.ToStringSet(), menge.StringSet(ms)) || !u.Equals(menge.FromStringSet(menge.StringSet(mu))) {
+				t.Errorf("%q and %q were modified: %v %v", a, b, s, u)
+			}
+		}
+		s, ms := menge.NewTrieStringSet(a...), menge.NewSet(a...)
+		short := func(e string) bool { return len(e) < 2 }
+		in, out := s.Partition(short)
+		if !reflect.DeepEqual(in.ToStringSet(), menge.StringSet(ms.Filter(short))) || in.Size()+out.Size() != s.Size() || !s.Filter(short).Equals(in) {
+			t.Errorf("%q Partition got: %v %v", a, in, out)
+		}
+		if s.Any(short) != ms.Any(short) || s.Every(short) != ms.Every(short) || s.None(short) != ms.None(short) || s.Count(short) != ms.Count(short) {
+			t.Errorf("%q predicates got different results", a)
+		}
+		u := s.Clone()
+		u.RemoveIf(short)
+		s.Retain(func(e string) bool { return !short(e) })
+		if !u.Equals(out) || !s.Equals(out) {
+			t.Errorf("%q RemoveIf and Retain got: %v %v", a, u, s)
+		}
+	}
+}
+
+func TestTrieStringSet_Routes(t *testing.T) {
+	s := menge.NewTrieStringSet("/", "/api/", "/api/users/", "/static/", "/apiary")
+	for _, tc := range []struct {
+		path, want string
+	}{
+		{"/api/users/42", "/api/users/"},
+		{"/api/user", "/api/"},
+		{"/apiary/bees", "/apiary"},
+		{"/api", "/"},
+		{"/", "/"},
+	} {
+		if got, ok := s.LongestPrefixOf(tc.path); !ok || got != tc.want {
+			t.Errorf("LongestPrefixOf %q got: %q %v", tc.path, got, ok)
+		}
+	}
+	if got, ok := s.LongestPrefixOf("api"); ok {
+		t.Errorf("LongestPrefixOf without a match got: %q", got)
+	}
+	var got []string
+	s.AscendPrefix("/api", func(e string) bool {
+		got = append(got, e)
+		return len(got) < 2
+	})
+	if !reflect.DeepEqual(got, []string{"/api/", "/api/users/"}) {
+		t.Errorf("AscendPrefix got: %q", got)
+	}
+	got = nil
+	s.AscendSuffix("/", func(e string) bool {
+		got = append(got, e)
+		return true
+	})
+	if !reflect.DeepEqual(got, []string{"/", "/api/", "/api/users/", "/static/"}) || !s.HasSuffix("ary") || s.HasSuffix("x") {
+		t.Errorf("AscendSuffix got: %q", got)
+	}
+	s.Remove("/api/", "/apiary")
+	if !s.HasPrefix("/api/u") || s.HasPrefix("/api/x") || s.Has("/api/") || !s.Has("/api/users/") {
+		t.Errorf("after removal got: %v", s)
+	}
+}
+
+func TestTrieStringSet_Encoding(t *testing.T) {
+	s := menge.NewTrieStringSet("b", "a")
+	if got := fmt.Sprintf("%v %+v %q %#v", s, s, s, s); got != `{a b} {a b} (size 2) {"a" "b"} menge.NewTrieStringSet("a", "b")` {
+		t.Errorf("Format got: %s", got)
+	}
+	data, err := json.Marshal(s)
+	if err != nil || string(data) != `["a","b"]` {
+		t.Errorf("MarshalJSON got: %s %v", data, err)
+	}
+	var u menge.TrieStringSet
+	if err := json.Unmarshal([]byte(`["x","xy","x"]`), &u); err != nil || u.String() != "{x xy}" {
+		t.Errorf("UnmarshalJSON got: %v %v", &u, err)
+	}
+}
+
+func BenchmarkTrieStringSet(b *testing.B) {
+	var elems []string
+	for i := 0; i < 100000; i++ {
+		elems = append(elems, fmt.Sprintf("/api/v%d/users/%d", i%3, i))
+	}
+	ms, ts := menge.NewStringSet(elems...), menge.NewTrieStringSet(elems...)
+	const prefix = "/api/v1/users/4242"
+	b.Run("WithPrefix/Map", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ms.Count(func(e string) bool { return strings.HasPrefix(e, prefix) })
+		}
+	})
+	b.Run("WithPrefix/Trie", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			n := 0
+			ts.AscendPrefix(prefix, func(string) bool {
+				n++
+				return true
+			})
+		}
+	})
+	b.Run("Has/Map", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ms.Has(elems[i%len(elems)])
+		}
+	})
+	b.Run("Has/Trie", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ts.Has(elems[i%len(elems)])
+		}
+	})
+}
@@ -0,0 +1,29 @@
+// Code generated by mengegen. DO NOT EDIT.
+
+//go:build go1.23
+
+package menge
+
+import "iter"
+
+// All returns an iterator over the elements of the set,
+// with no specific order of the elements.
+func (s UInt16Set) All() iter.Seq[uint16] {
+	return Set[uint16](s).All()
+}
+
+// Sorted returns an iterator over the elements of the set,
+// in their natural order, as used by String.
+// The elements are sorted when the iteration starts.
+func (s UInt16Set) Sorted() iter.Seq[uint16] {
+	return Set[uint16](s).Sorted()
+}
+
+// CollectUInt16Set returns a new UInt16Set containing the elements of seq.
+func CollectUInt16Set(seq iter.Seq[uint16]) UInt16Set {
+	s := NewUInt16Set()
+	for e := range seq {
+		s.Add(e)
+	}
+	return s
+}
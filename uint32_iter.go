@@ -0,0 +1,29 @@
+// Code generated by mengegen. DO NOT EDIT.
+
+//go:build go1.23
+
+package menge
+
+import "iter"
+
+// All returns an iterator over the elements of the set,
+// with no specific order of the elements.
+func (s UInt32Set) All() iter.Seq[uint32] {
+	return Set[uint32](s).All()
+}
+
+// Sorted returns an iterator over the elements of the set,
+// in their natural order, as used by String.
+// The elements are sorted when the iteration starts.
+func (s UInt32Set) Sorted() iter.Seq[uint32] {
+	return Set[uint32](s).Sorted()
+}
+
+// CollectUInt32Set returns a new UInt32Set containing the elements of seq.
+func CollectUInt32Set(seq iter.Seq[uint32]) UInt32Set {
+	s := NewUInt32Set()
+	for e := range seq {
+		s.Add(e)
+	}
+	return s
+}
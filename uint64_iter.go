@@ -0,0 +1,29 @@
+// Code generated by mengegen. DO NOT EDIT.
+
+//go:build go1.23
+
+package menge
+
+import "iter"
+
+// All returns an iterator over the elements of the set,
+// with no specific order of the elements.
+func (s UInt64Set) All() iter.Seq[uint64] {
+	return Set[uint64](s).All()
+}
+
+// Sorted returns an iterator over the elements of the set,
+// in their natural order, as used by String.
+// The elements are sorted when the iteration starts.
+func (s UInt64Set) Sorted() iter.Seq[uint64] {
+	return Set[uint64](s).Sorted()
+}
+
+// CollectUInt64Set returns a new UInt64Set containing the elements of seq.
+func CollectUInt64Set(seq iter.Seq[uint64]) UInt64Set {
+	s := NewUInt64Set()
+	for e := range seq {
+		s.Add(e)
+	}
+	return s
+}
@@ -0,0 +1,29 @@
+// Code generated by mengegen. DO NOT EDIT.
+
+//go:build go1.23
+
+package menge
+
+import "iter"
+
+// All returns an iterator over the elements of the set,
+// with no specific order of the elements.
+func (s UInt8Set) All() iter.Seq[uint8] {
+	return Set[uint8](s).All()
+}
+
+// Sorted returns an iterator over the elements of the set,
+// in their natural order, as used by String.
+// The elements are sorted when the iteration starts.
+func (s UInt8Set) Sorted() iter.Seq[uint8] {
+	return Set[uint8](s).Sorted()
+}
+
+// CollectUInt8Set returns a new UInt8Set containing the elements of seq.
+func CollectUInt8Set(seq iter.Seq[uint8]) UInt8Set {
+	s := NewUInt8Set()
+	for e := range seq {
+		s.Add(e)
+	}
+	return s
+}
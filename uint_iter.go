@@ -0,0 +1,29 @@
+// Code generated by mengegen. DO NOT EDIT.
+
+//go:build go1.23
+
+package menge
+
+import "iter"
+
+// All returns an iterator over the elements of the set,
+// with no specific order of the elements.
+func (s UIntSet) All() iter.Seq[uint] {
+	return Set[uint](s).All()
+}
+
+// Sorted returns an iterator over the elements of the set,
+// in their natural order, as used by String.
+// The elements are sorted when the iteration starts.
+func (s UIntSet) Sorted() iter.Seq[uint] {
+	return Set[uint](s).Sorted()
+}
+
+// CollectUIntSet returns a new UIntSet containing the elements of seq.
+func CollectUIntSet(seq iter.Seq[uint]) UIntSet {
+	s := NewUIntSet()
+	for e := range seq {
+		s.Add(e)
+	}
+	return s
+}
@@ -0,0 +1,29 @@
+// Code generated by mengegen. DO NOT EDIT.
+
+//go:build go1.23
+
+package menge
+
+import "iter"
+
+// All returns an iterator over the elements of the set,
+// with no specific order of the elements.
+func (s UIntPtrSet) All() iter.Seq[uintptr] {
+	return Set[uintptr](s).All()
+}
+
+// Sorted returns an iterator over the elements of the set,
+// in their natural order, as used by String.
+// The elements are sorted when the iteration starts.
+func (s UIntPtrSet) Sorted() iter.Seq[uintptr] {
+	return Set[uintptr](s).Sorted()
+}
+
+// CollectUIntPtrSet returns a new UIntPtrSet containing the elements of seq.
+func CollectUIntPtrSet(seq iter.Seq[uintptr]) UIntPtrSet {
+	s := NewUIntPtrSet()
+	for e := range seq {
+		s.Add(e)
+	}
+	return s
+}